@@ -258,6 +258,12 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 		})
 	}
 
+	// Record the head block along with the served RPC calls.
+	if recorder := stack.RPCRecorder(); recorder != nil {
+		recorder.SetHeadFunc(func() uint64 {
+			return backend.CurrentHeader().Number.Uint64()
+		})
+	}
 	// Configure log filter RPC API.
 	filterSystem := utils.RegisterFilterAPI(stack, backend, &cfg.Eth)
 
@@ -265,6 +271,11 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 	if ctx.IsSet(utils.GraphQLEnabledFlag.Name) {
 		utils.RegisterGraphQLService(stack, backend, filterSystem, &cfg.Node)
 	}
+	// Configure the protobuf RPC transport if requested.
+	if ctx.IsSet(utils.ProtoRPCEnabledFlag.Name) {
+		modules := utils.SplitAndTrim(ctx.String(utils.ProtoRPCApiFlag.Name))
+		utils.RegisterProtoRPCService(stack, &cfg.Node, modules)
+	}
 	// Add the Ethereum Stats daemon if requested.
 	if cfg.Ethstats.URL != "" {
 		utils.RegisterEthStatsService(stack, backend, cfg.Ethstats.URL)
@@ -69,6 +69,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/protorpc"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/triedb"
 	"github.com/ethereum/go-ethereum/triedb/hashdb"
@@ -295,6 +296,11 @@ var (
 		Value:    ethconfig.Defaults.StateHistory,
 		Category: flags.StateCategory,
 	}
+	StateHistoryIndexFlag = &cli.BoolFlag{
+		Name:     "history.state.index",
+		Usage:    "Index the retained state history to serve historical state queries without archive mode, only relevant in state.scheme=path",
+		Category: flags.StateCategory,
+	}
 	TrienodeHistoryFlag = &cli.Int64Flag{
 		Name:     "history.trienode",
 		Usage:    "Number of recent blocks to retain trienode history for, only relevant in state.scheme=path (default/negative = disabled, 0 = entire chain)",
@@ -416,6 +422,18 @@ var (
 		Value:    ethconfig.Defaults.TxPool.Rejournal,
 		Category: flags.TxPoolCategory,
 	}
+	TxPoolSnapshotFlag = &cli.StringFlag{
+		Name:     "txpool.snapshot",
+		Usage:    "Disk snapshot of all pooled transactions to survive node restarts (disabled if empty)",
+		Value:    ethconfig.Defaults.TxPool.Snapshot,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolSnapshotIntervalFlag = &cli.DurationFlag{
+		Name:     "txpool.snapshotinterval",
+		Usage:    "Time interval to regenerate the transaction pool snapshot",
+		Value:    ethconfig.Defaults.TxPool.SnapshotInterval,
+		Category: flags.TxPoolCategory,
+	}
 	TxPoolPriceLimitFlag = &cli.Uint64Flag{
 		Name:     "txpool.pricelimit",
 		Usage:    "Minimum gas price tip to enforce for acceptance into the pool",
@@ -477,6 +495,12 @@ var (
 		Value:    ethconfig.Defaults.BlobPool.PriceBump,
 		Category: flags.BlobPoolCategory,
 	}
+	BlobPoolArchiveRetentionFlag = &cli.Uint64Flag{
+		Name:     "blobpool.archiveretention",
+		Usage:    "Number of blocks to retain the blobs of finalized transactions for (0 = disabled)",
+		Value:    ethconfig.Defaults.BlobPool.ArchiveRetention,
+		Category: flags.BlobPoolCategory,
+	}
 	// Performance tuning settings
 	CacheFlag = &cli.IntFlag{
 		Name:     "cache",
@@ -570,6 +594,17 @@ var (
 		Usage:    "Maximum number of blobs per block (falls back to protocol maximum if unspecified)",
 		Category: flags.MinerCategory,
 	}
+	MinerOrderingFlag = &cli.StringFlag{
+		Name:     "miner.ordering",
+		Usage:    "Transaction ordering policy for built blocks (price, fifo, roundrobin)",
+		Value:    miner.OrderingPriceNonce,
+		Category: flags.MinerCategory,
+	}
+	MinerPriorityAddressesFlag = &cli.StringFlag{
+		Name:     "miner.priority",
+		Usage:    "Comma separated list of senders whose transactions are included first",
+		Category: flags.MinerCategory,
+	}
 
 	// Account settings
 	PasswordFileFlag = &cli.PathFlag{
@@ -774,6 +809,22 @@ var (
 		Value:    strings.Join(node.DefaultConfig.GraphQLVirtualHosts, ","),
 		Category: flags.APICategory,
 	}
+	GraphQLTracingFlag = &cli.BoolFlag{
+		Name:     "graphql.tracing",
+		Usage:    "Enable the transaction tracing fields (trace, callFrames, stateDiff) of the GraphQL API",
+		Category: flags.APICategory,
+	}
+	ProtoRPCEnabledFlag = &cli.BoolFlag{
+		Name:     "protorpc",
+		Usage:    "Enable the protobuf RPC transport on the HTTP-RPC server, served over HTTP/2 at the /protorpc path",
+		Category: flags.APICategory,
+	}
+	ProtoRPCApiFlag = &cli.StringFlag{
+		Name:     "protorpc.api",
+		Usage:    "API's offered over the protobuf RPC transport (eth, debug, txpool)",
+		Value:    "eth",
+		Category: flags.APICategory,
+	}
 	WSEnabledFlag = &cli.BoolFlag{
 		Name:     "ws",
 		Usage:    "Enable the WS-RPC server",
@@ -836,6 +887,23 @@ var (
 		Value:    node.DefaultConfig.BatchResponseMaxSize,
 		Category: flags.APICategory,
 	}
+	RPCRecordFileFlag = &cli.StringFlag{
+		Name:     "rpc.record",
+		Usage:    "Record the calls served over HTTP and WebSocket, along with the responses, to the given file",
+		Category: flags.APICategory,
+	}
+	RPCRecordMaxSizeFlag = &cli.IntFlag{
+		Name:     "rpc.record.maxsize",
+		Usage:    "Maximum size in megabytes of the RPC recording before it gets rotated",
+		Value:    100,
+		Category: flags.APICategory,
+	}
+	RPCRecordMaxBackupsFlag = &cli.IntFlag{
+		Name:     "rpc.record.maxbackups",
+		Usage:    "Maximum number of rotated RPC recordings to retain",
+		Value:    10,
+		Category: flags.APICategory,
+	}
 
 	// Network Settings
 	MaxPeersFlag = &cli.IntFlag{
@@ -1286,6 +1354,12 @@ func setHTTP(ctx *cli.Context, cfg *node.Config) {
 	if ctx.IsSet(BatchResponseMaxSize.Name) {
 		cfg.BatchResponseMaxSize = ctx.Int(BatchResponseMaxSize.Name)
 	}
+
+	if ctx.IsSet(RPCRecordFileFlag.Name) {
+		cfg.RPCRecordFile = ctx.String(RPCRecordFileFlag.Name)
+		cfg.RPCRecordMaxSize = ctx.Int(RPCRecordMaxSizeFlag.Name)
+		cfg.RPCRecordMaxBackups = ctx.Int(RPCRecordMaxBackupsFlag.Name)
+	}
 }
 
 // setGraphQL creates the GraphQL listener interface string from the set
@@ -1297,6 +1371,9 @@ func setGraphQL(ctx *cli.Context, cfg *node.Config) {
 	if ctx.IsSet(GraphQLVirtualHostsFlag.Name) {
 		cfg.GraphQLVirtualHosts = SplitAndTrim(ctx.String(GraphQLVirtualHostsFlag.Name))
 	}
+	if ctx.IsSet(GraphQLTracingFlag.Name) {
+		cfg.GraphQLTracing = ctx.Bool(GraphQLTracingFlag.Name)
+	}
 }
 
 // setWS creates the WebSocket RPC listener interface string from the set
@@ -1555,6 +1632,12 @@ func setTxPool(ctx *cli.Context, cfg *legacypool.Config) {
 	if ctx.IsSet(TxPoolRejournalFlag.Name) {
 		cfg.Rejournal = ctx.Duration(TxPoolRejournalFlag.Name)
 	}
+	if ctx.IsSet(TxPoolSnapshotFlag.Name) {
+		cfg.Snapshot = ctx.String(TxPoolSnapshotFlag.Name)
+	}
+	if ctx.IsSet(TxPoolSnapshotIntervalFlag.Name) {
+		cfg.SnapshotInterval = ctx.Duration(TxPoolSnapshotIntervalFlag.Name)
+	}
 	if ctx.IsSet(TxPoolPriceLimitFlag.Name) {
 		cfg.PriceLimit = ctx.Uint64(TxPoolPriceLimitFlag.Name)
 	}
@@ -1588,6 +1671,9 @@ func setBlobPool(ctx *cli.Context, cfg *blobpool.Config) {
 	if ctx.IsSet(BlobPoolPriceBumpFlag.Name) {
 		cfg.PriceBump = ctx.Uint64(BlobPoolPriceBumpFlag.Name)
 	}
+	if ctx.IsSet(BlobPoolArchiveRetentionFlag.Name) {
+		cfg.ArchiveRetention = ctx.Uint64(BlobPoolArchiveRetentionFlag.Name)
+	}
 }
 
 func setMiner(ctx *cli.Context, cfg *miner.Config) {
@@ -1613,6 +1699,22 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.IsSet(MinerMaxBlobsFlag.Name) {
 		cfg.MaxBlobsPerBlock = ctx.Int(MinerMaxBlobsFlag.Name)
 	}
+	if ctx.IsSet(MinerOrderingFlag.Name) {
+		cfg.Ordering = ctx.String(MinerOrderingFlag.Name)
+	}
+	if _, err := miner.LookupOrderingPolicy(cfg.Ordering); err != nil {
+		Fatalf("Option %q: %v", MinerOrderingFlag.Name, err)
+	}
+	if ctx.IsSet(MinerPriorityAddressesFlag.Name) {
+		cfg.PriorityAddresses = nil
+		for _, account := range strings.Split(ctx.String(MinerPriorityAddressesFlag.Name), ",") {
+			if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
+				Fatalf("Invalid priority address %q", account)
+			} else {
+				cfg.PriorityAddresses = append(cfg.PriorityAddresses, common.HexToAddress(trimmed))
+			}
+		}
+	}
 }
 
 func setRequiredBlocks(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1723,6 +1825,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(StateHistoryFlag.Name) {
 		cfg.StateHistory = ctx.Uint64(StateHistoryFlag.Name)
 	}
+	if ctx.IsSet(StateHistoryIndexFlag.Name) {
+		cfg.StateHistoryIndex = ctx.Bool(StateHistoryIndexFlag.Name)
+	}
 	if ctx.IsSet(TrienodeHistoryFlag.Name) {
 		cfg.TrienodeHistory = ctx.Int64(TrienodeHistoryFlag.Name)
 	}
@@ -2119,12 +2224,20 @@ func RegisterEthStatsService(stack *node.Node, backend *eth.EthAPIBackend, url s
 
 // RegisterGraphQLService adds the GraphQL API to the node.
 func RegisterGraphQLService(stack *node.Node, backend ethapi.Backend, filterSystem *filters.FilterSystem, cfg *node.Config) {
-	err := graphql.New(stack, backend, filterSystem, cfg.GraphQLCors, cfg.GraphQLVirtualHosts)
+	err := graphql.New(stack, backend, filterSystem, cfg.GraphQLCors, cfg.GraphQLVirtualHosts, cfg.GraphQLTracing)
 	if err != nil {
 		Fatalf("Failed to register the GraphQL service: %v", err)
 	}
 }
 
+// RegisterProtoRPCService adds the protobuf RPC transport to the node.
+func RegisterProtoRPCService(stack *node.Node, cfg *node.Config, modules []string) {
+	err := protorpc.New(stack, modules, cfg.HTTPCors, cfg.HTTPVirtualHosts)
+	if err != nil {
+		Fatalf("Failed to register the protobuf RPC service: %v", err)
+	}
+}
+
 // RegisterFilterAPI adds the eth log filtering RPC API to the node.
 func RegisterFilterAPI(stack *node.Node, backend ethapi.Backend, ethcfg *ethconfig.Config) *filters.FilterSystem {
 	filterSystem := filters.NewFilterSystem(backend, filters.Config{
@@ -2342,6 +2455,7 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readonly bool) (*core.BlockCh
 		Preimages:               ctx.Bool(CachePreimagesFlag.Name),
 		StateScheme:             scheme,
 		StateHistory:            ctx.Uint64(StateHistoryFlag.Name),
+		StateHistoryIndex:       ctx.Bool(StateHistoryIndexFlag.Name),
 		TrienodeHistory:         ctx.Int64(TrienodeHistoryFlag.Name),
 		NodeFullValueCheckpoint: uint32(ctx.Uint(TrienodeHistoryFullValueCheckpointFlag.Name)),
 
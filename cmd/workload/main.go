@@ -51,6 +51,7 @@ func init() {
 		proofGenerateCommand,
 		filterPerfCommand,
 		filterFuzzCommand,
+		replayCommand,
 	}
 }
 
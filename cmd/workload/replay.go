@@ -0,0 +1,460 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/internal/flags"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	replayCommand = &cli.Command{
+		Name:      "replay",
+		Usage:     "Replays recorded RPC calls against an RPC endpoint and diffs the responses",
+		ArgsUsage: "<RPC endpoint URL>",
+		Action:    replayCmd,
+		Flags: []cli.Flag{
+			replayRecordingFlag,
+			replaySkipFlag,
+			replayPinHeadFlag,
+			replayDiffFileFlag,
+		},
+	}
+	replayRecordingFlag = &cli.StringSliceFlag{
+		Name:     "recording",
+		Usage:    "Recording of RPC calls made with geth --rpc.record, may be given multiple times to replay rotated files in order",
+		Required: true,
+		Category: flags.TestingCategory,
+	}
+	replaySkipFlag = &cli.StringFlag{
+		Name:     "skip",
+		Usage:    "Comma separated list of methods not to replay, methods which may change the state of the node are never replayed",
+		Value:    strings.Join(replaySkipDefault, ","),
+		Category: flags.TestingCategory,
+	}
+	replayPinHeadFlag = &cli.BoolFlag{
+		Name:     "pin-head",
+		Usage:    "Replace the 'latest' block tag with the head block at the time of recording",
+		Value:    true,
+		Category: flags.TestingCategory,
+	}
+	replayDiffFileFlag = &cli.StringFlag{
+		Name:     "diffs",
+		Usage:    "JSON file containing the calls with differing responses",
+		Value:    "replay_diffs.json",
+		Category: flags.TestingCategory,
+	}
+)
+
+// replaySkipDefault are the methods not replayed by default, as their responses
+// are expected to differ between nodes.
+var replaySkipDefault = []string{
+	"eth_blockNumber",
+	"eth_syncing",
+}
+
+// replayReadOnly are the methods which may be replayed. Any other method might
+// change the state of the target node, and is never replayed.
+var replayReadOnly = map[string]bool{
+	"eth_blockNumber":                         true,
+	"eth_chainId":                             true,
+	"eth_syncing":                             true,
+	"eth_gasPrice":                            true,
+	"eth_maxPriorityFeePerGas":                true,
+	"eth_blobBaseFee":                         true,
+	"eth_feeHistory":                          true,
+	"eth_getBalance":                          true,
+	"eth_getCode":                             true,
+	"eth_getStorageAt":                        true,
+	"eth_getTransactionCount":                 true,
+	"eth_getProof":                            true,
+	"eth_call":                                true,
+	"eth_simulateV1":                          true,
+	"eth_estimateGas":                         true,
+	"eth_estimateGasBundle":                   true,
+	"eth_createAccessList":                    true,
+	"eth_getHeaderByNumber":                   true,
+	"eth_getHeaderByHash":                     true,
+	"eth_getBlockByNumber":                    true,
+	"eth_getBlockByHash":                      true,
+	"eth_getBlockReceipts":                    true,
+	"eth_getBlockTransactionCountByNumber":    true,
+	"eth_getBlockTransactionCountByHash":      true,
+	"eth_getUncleByBlockNumberAndIndex":       true,
+	"eth_getUncleByBlockHashAndIndex":         true,
+	"eth_getUncleCountByBlockNumber":          true,
+	"eth_getUncleCountByBlockHash":            true,
+	"eth_getTransactionByHash":                true,
+	"eth_getTransactionByBlockNumberAndIndex": true,
+	"eth_getTransactionByBlockHashAndIndex":   true,
+	"eth_getRawTransactionByHash":             true,
+	"eth_getTransactionReceipt":               true,
+	"eth_getLogs":                             true,
+	"eth_getLogsPage":                         true,
+	"debug_traceBlockByNumber":                true,
+	"debug_traceBlockByHash":                  true,
+	"debug_traceTransaction":                  true,
+	"debug_traceCall":                         true,
+	"debug_getRawHeader":                      true,
+	"debug_getRawBlock":                       true,
+	"debug_getRawReceipts":                    true,
+	"debug_getRawTransaction":                 true,
+	"debug_storageRangeAt":                    true,
+	"debug_accountRange":                      true,
+	"debug_getModifiedAccountsByNumber":       true,
+	"debug_getModifiedAccountsByHash":         true,
+	"net_version":                             true,
+	"web3_clientVersion":                      true,
+	"web3_sha3":                               true,
+}
+
+// replayDiff is a recorded call whose replayed response differs.
+type replayDiff struct {
+	Index    int             `json:"index"`
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params,omitempty"`
+	Head     *uint64         `json:"head,omitempty"`
+	Diff     string          `json:"diff"`
+	Expected json.RawMessage `json:"expected"`
+	Actual   json.RawMessage `json:"actual"`
+}
+
+// replayCmd is the main function of the replay tool.
+func replayCmd(ctx *cli.Context) error {
+	var (
+		client  = makeClient(ctx)
+		skip    = strings.Split(ctx.String(replaySkipFlag.Name), ",")
+		pinHead = ctx.Bool(replayPinHeadFlag.Name)
+
+		index, replayed, skipped int
+		diffs                    []*replayDiff
+		durations                = make(map[string][2]time.Duration) // recorded and replayed
+	)
+	for _, file := range ctx.StringSlice(replayRecordingFlag.Name) {
+		err := readRecording(file, func(call *rpc.RecordedCall) error {
+			index++
+			if !replayReadOnly[call.Method] || slices.Contains(skip, call.Method) {
+				skipped++
+				return nil
+			}
+			params, err := replayParams(call, pinHead)
+			if err != nil {
+				fmt.Printf("Skipping call #%d to %s: %v\n", index, call.Method, err)
+				skipped++
+				return nil
+			}
+			start := time.Now()
+			result, callErr := replayCall(ctx.Context, client.RPC, call.Method, params)
+			elapsed := time.Since(start)
+			if _, ok := errorCode(callErr); callErr != nil && !ok {
+				return fmt.Errorf("call #%d to %s failed: %v", index, call.Method, callErr)
+			}
+			replayed++
+			d := durations[call.Method]
+			d[0], d[1] = d[0]+call.Duration, d[1]+elapsed
+			durations[call.Method] = d
+
+			if diff := diffResponse(call, result, callErr); diff != nil {
+				diff.Index = index
+				diffs = append(diffs, diff)
+				fmt.Printf("Response mismatch in call #%d to %s: %s\n", index, call.Method, diff.Diff)
+			}
+			if replayed%1000 == 0 {
+				fmt.Println(" replayed:", replayed, "skipped:", skipped, "mismatch:", len(diffs))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Println("Replay finished; replayed:", replayed, "skipped:", skipped, "mismatch:", len(diffs))
+
+	// Show the total time spent per method, compared to the recording.
+	methods := make([]string, 0, len(durations))
+	for method := range durations {
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return durations[methods[i]][1] > durations[methods[j]][1]
+	})
+	for _, method := range methods {
+		d := durations[method]
+		fmt.Printf("%-40s recorded: %13v  replayed: %13v\n", method, d[0], d[1])
+	}
+	writeReplayDiffs(ctx.String(replayDiffFileFlag.Name), diffs)
+	return nil
+}
+
+// readRecording decodes the calls of a recording file one by one.
+func readRecording(file string, fn func(*rpc.RecordedCall) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 256*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		call := new(rpc.RecordedCall)
+		if err := json.Unmarshal(scanner.Bytes(), call); err != nil {
+			return fmt.Errorf("invalid recording %s, line %d: %v", file, line, err)
+		}
+		if err := fn(call); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// optionalBlockParams are the positions of the block parameters of the methods
+// which query the latest block if the parameter is omitted.
+var optionalBlockParams = map[string]int{
+	"eth_call":             1,
+	"eth_estimateGas":      1,
+	"eth_createAccessList": 1,
+	"eth_simulateV1":       1,
+}
+
+// replayParams returns the positional parameters of a recorded call. If pinHead
+// is set, the 'latest' block tag is replaced with the head block at the time of
+// the recording, such that the replayed call queries the same state. Omitted
+// block parameters defaulting to the latest block are pinned as well.
+func replayParams(call *rpc.RecordedCall, pinHead bool) ([]any, error) {
+	if len(call.Params) == 0 {
+		return nil, nil
+	}
+	var params []any
+	if err := json.Unmarshal(call.Params, &params); err != nil {
+		return nil, errors.New("parameters are not positional")
+	}
+	if pinHead && call.Head != nil {
+		head := hexutil.EncodeUint64(*call.Head)
+		for i := range params {
+			params[i] = replaceBlockTag(params[i], "latest", head)
+		}
+		if i, ok := optionalBlockParams[call.Method]; ok {
+			switch {
+			case len(params) == i:
+				params = append(params, head)
+			case len(params) > i && params[i] == nil:
+				params[i] = head
+			}
+		}
+		// Log filters without a block hash default to the latest block for both
+		// ends of the range.
+		if call.Method == "eth_getLogs" && len(params) > 0 {
+			if crit, ok := params[0].(map[string]any); ok && crit["blockHash"] == nil {
+				for _, key := range []string{"fromBlock", "toBlock"} {
+					if crit[key] == nil {
+						crit[key] = head
+					}
+				}
+			}
+		}
+	}
+	return params, nil
+}
+
+// replaceBlockTag replaces a block tag in a decoded parameter, descending into
+// objects such as filter criteria and call overrides.
+func replaceBlockTag(v any, tag, number string) any {
+	switch v := v.(type) {
+	case string:
+		if v == tag {
+			return number
+		}
+	case map[string]any:
+		for key, field := range v {
+			v[key] = replaceBlockTag(field, tag, number)
+		}
+	case []any:
+		for i := range v {
+			v[i] = replaceBlockTag(v[i], tag, number)
+		}
+	}
+	return v
+}
+
+// replayCall sends a call and returns its raw result.
+func replayCall(ctx context.Context, client *rpc.Client, method string, params []any) (json.RawMessage, error) {
+	var result json.RawMessage
+	err := client.CallContext(ctx, &result, method, params...)
+	return result, err
+}
+
+// errorCode returns the code of an error response of the server. It returns false
+// if err is a transport failure instead.
+func errorCode(err error) (int, bool) {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return 0, false
+	}
+	return rpcErr.ErrorCode(), true
+}
+
+// diffResponse compares the replayed response of a call with the recorded one.
+// Errors are considered equal if their codes match, as the messages commonly
+// differ between implementations.
+func diffResponse(call *rpc.RecordedCall, result json.RawMessage, callErr error) *replayDiff {
+	diff := &replayDiff{
+		Method:   call.Method,
+		Params:   call.Params,
+		Head:     call.Head,
+		Expected: call.Result,
+		Actual:   result,
+	}
+	if call.Error != nil {
+		diff.Expected = call.Error
+	}
+	code, _ := errorCode(callErr)
+	if callErr != nil {
+		diff.Actual, _ = json.Marshal(map[string]any{
+			"code":    code,
+			"message": callErr.Error(),
+		})
+	}
+	switch {
+	case call.Error != nil && callErr != nil:
+		var recorded struct {
+			Code int `json:"code"`
+		}
+		json.Unmarshal(call.Error, &recorded)
+		if recorded.Code != code {
+			diff.Diff = fmt.Sprintf("error code %d != %d", recorded.Code, code)
+			return diff
+		}
+		return nil
+
+	case call.Error != nil:
+		diff.Diff = "expected error, got result"
+		return diff
+
+	case callErr != nil:
+		diff.Diff = fmt.Sprintf("unexpected error: %v", callErr)
+		return diff
+	}
+	var expected, actual any
+	if err := json.Unmarshal(orNull(call.Result), &expected); err != nil {
+		diff.Diff = fmt.Sprintf("invalid recorded result: %v", err)
+		return diff
+	}
+	if err := json.Unmarshal(orNull(result), &actual); err != nil {
+		diff.Diff = fmt.Sprintf("invalid result: %v", err)
+		return diff
+	}
+	if d := diffJSON("result", expected, actual); d != "" {
+		diff.Diff = d
+		return diff
+	}
+	return nil
+}
+
+// diffJSON returns a description of the first difference between two decoded
+// JSON values, or the empty string if they are equal.
+func diffJSON(path string, expected, actual any) string {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(e)+len(a))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		for key := range a {
+			if _, ok := e[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			ev, eok := e[key]
+			av, aok := a[key]
+			switch {
+			case !aok:
+				return fmt.Sprintf("%s.%s missing", path, key)
+			case !eok:
+				return fmt.Sprintf("%s.%s unexpected", path, key)
+			}
+			if d := diffJSON(path+"."+key, ev, av); d != "" {
+				return d
+			}
+		}
+		return ""
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		if len(e) != len(a) {
+			return fmt.Sprintf("%s length %d != %d", path, len(e), len(a))
+		}
+		for i := range e {
+			if d := diffJSON(fmt.Sprintf("%s[%d]", path, i), e[i], a[i]); d != "" {
+				return d
+			}
+		}
+		return ""
+	}
+	if reflect.DeepEqual(expected, actual) {
+		return ""
+	}
+	return fmt.Sprintf("%s %s != %s", path, formatJSON(expected), formatJSON(actual))
+}
+
+func formatJSON(v any) string {
+	enc, _ := json.Marshal(v)
+	if len(enc) > 100 {
+		return string(enc[:100]) + "..."
+	}
+	return string(enc)
+}
+
+func orNull(v json.RawMessage) json.RawMessage {
+	if len(v) == 0 {
+		return json.RawMessage("null")
+	}
+	return v
+}
+
+func writeReplayDiffs(diffFile string, diffs []*replayDiff) {
+	file, err := os.Create(diffFile)
+	if err != nil {
+		exit(fmt.Errorf("Error creating replay diff file %s: %v", diffFile, err))
+		return
+	}
+	defer file.Close()
+	json.NewEncoder(file).Encode(diffs)
+}
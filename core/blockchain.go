@@ -178,6 +178,11 @@ type BlockChainConfig struct {
 	// If set to 0, all state histories across the entire chain will be retained;
 	StateHistory uint64
 
+	// StateHistoryIndex enables the indexing of the retained state histories,
+	// allowing historical state to be read in path scheme without archive mode.
+	// Indexing is always enabled in archive mode.
+	StateHistoryIndex bool
+
 	// Number of blocks from the chain head for which trienode histories are retained.
 	// If set to 0, all trienode histories across the entire chain will be retained;
 	// If set to -1, no trienode history will be retained;
@@ -213,6 +218,11 @@ type BlockChainConfig struct {
 	// StateSizeTracking indicates whether the state size tracking is enabled.
 	StateSizeTracking bool
 
+	// StateFork is the remote state the chain is forked from. Any state not
+	// modified locally is resolved from it on demand. Forking requires the hash
+	// scheme with the state snapshot disabled.
+	StateFork state.ForkSource
+
 	// SlowBlockThreshold is the block execution time threshold beyond which
 	// detailed statistics will be logged. Negative value means disabled (default),
 	// zero logs all blocks, positive value filters blocks by execution time.
@@ -278,7 +288,7 @@ func (cfg *BlockChainConfig) triedbConfig(isVerkle bool) *triedb.Config {
 			// Historical state configurations
 			StateHistory:        cfg.StateHistory,
 			TrienodeHistory:     cfg.TrienodeHistory,
-			EnableStateIndexing: cfg.ArchiveMode,
+			EnableStateIndexing: cfg.ArchiveMode || cfg.StateHistoryIndex,
 			FullValueCheckpoint: cfg.NodeFullValueCheckpoint,
 
 			// Testing configurations
@@ -373,6 +383,9 @@ func NewBlockChain(db ethdb.Database, genesis *Genesis, engine consensus.Engine,
 	if cfg == nil {
 		cfg = DefaultConfig()
 	}
+	if cfg.StateFork != nil && (cfg.StateScheme != rawdb.HashScheme || cfg.SnapshotLimit > 0) {
+		return nil, errors.New("state forking requires the hash scheme without snapshots")
+	}
 
 	// Open trie database with provided config
 	enableVerkle, err := EnableVerkleAtGenesis(db, genesis)
@@ -418,7 +431,7 @@ func NewBlockChain(db ethdb.Database, genesis *Genesis, engine consensus.Engine,
 		return nil, err
 	}
 	bc.flushInterval.Store(int64(cfg.TrieTimeLimit))
-	bc.statedb = state.NewDatabase(bc.triedb, nil)
+	bc.statedb = state.NewDatabase(bc.triedb, nil).WithFork(cfg.StateFork)
 	bc.validator = NewBlockValidator(chainConfig, bc)
 	bc.prefetcher = newStatePrefetcher(chainConfig, bc.hc)
 	bc.processor = NewStateProcessor(bc.hc)
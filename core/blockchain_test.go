@@ -4557,3 +4557,70 @@ func TestSetHeadBeyondRootFinalizedBug(t *testing.T) {
 			currentFinal.Number.Uint64())
 	}
 }
+
+// Tests that historical state older than the in-memory layers can be read from
+// the indexed state history in path scheme, without running in archive mode.
+func TestHistoricStateWithHistoryIndex(t *testing.T) {
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		to      = common.HexToAddress("0xdeadbeef")
+		funds   = big.NewInt(params.Ether)
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: types.GenesisAlloc{addr: {Balance: funds}}, BaseFee: big.NewInt(params.InitialBaseFee)}
+		signer  = types.LatestSigner(gspec.Config)
+		engine  = ethash.NewFaker()
+		nblocks = 2 * state.TriesInMemory
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, nblocks, func(i int, b *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(addr), to, big.NewInt(int64(i+1)), params.TxGas, b.header.BaseFee, nil), signer, key)
+		b.AddTx(tx)
+	})
+	// State histories are stored in the freezer, which requires an ancient store.
+	db, err := rawdb.Open(rawdb.NewMemoryDatabase(), rawdb.OpenOptions{Ancient: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	cfg := DefaultConfig().WithStateScheme(rawdb.PathScheme)
+	cfg.StateHistoryIndex = true
+	cfg.TrienodeHistory = -1
+
+	chain, err := NewBlockChain(db, gspec, engine, cfg)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	// Block 10 has been flushed out of the in-memory layers.
+	root := blocks[9].Root()
+	if _, err := chain.StateAt(root); err == nil {
+		t.Fatal("expected state to be unavailable as live state")
+	}
+	var (
+		statedb *state.StateDB
+		timeout = time.After(10 * time.Second)
+	)
+	for {
+		if statedb, err = chain.HistoricState(root); err == nil {
+			if progress, _ := chain.StateIndexProgress(); progress == 0 {
+				break
+			}
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("historic state not available: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	// The recipient received 1+2+...+10 wei in the first ten blocks.
+	if balance := statedb.GetBalance(to); balance.Uint64() != 55 {
+		t.Fatalf("wrong historic balance: have %d, want 55", balance)
+	}
+	if nonce := statedb.GetNonce(addr); nonce != 10 {
+		t.Fatalf("wrong historic nonce: have %d, want 10", nonce)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// ReadBlobArchiveMeta retrieves the metadata of the archived blob sidecars with
+// the specified id.
+func ReadBlobArchiveMeta(db ethdb.AncientReaderOp, id uint64) []byte {
+	blob, err := db.Ancient(blobArchiveMetaTable, id)
+	if err != nil {
+		return nil
+	}
+	return blob
+}
+
+// ReadBlobArchiveMetaList retrieves a batch of meta objects with the specified
+// start position and count.
+func ReadBlobArchiveMetaList(db ethdb.AncientReaderOp, start uint64, count uint64) ([][]byte, error) {
+	return db.AncientRange(blobArchiveMetaTable, start, count, 0)
+}
+
+// ReadBlobArchiveSidecars retrieves the archived blob sidecars with the specified id.
+func ReadBlobArchiveSidecars(db ethdb.AncientReaderOp, id uint64) []byte {
+	blob, err := db.Ancient(blobArchiveSidecarsTable, id)
+	if err != nil {
+		return nil
+	}
+	return blob
+}
+
+// WriteBlobArchive writes the provided blob sidecars and their metadata into
+// the archive with the specified id.
+func WriteBlobArchive(db ethdb.AncientWriter, id uint64, meta []byte, sidecars []byte) error {
+	_, err := db.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+		if err := op.AppendRaw(blobArchiveMetaTable, id, meta); err != nil {
+			return err
+		}
+		return op.AppendRaw(blobArchiveSidecarsTable, id, sidecars)
+	})
+	return err
+}
@@ -92,6 +92,22 @@ var trienodeFreezerTableConfigs = map[string]freezerTableConfig{
 	trienodeHistoryValueSectionTable: {noSnappy: true, prunable: true},
 }
 
+const (
+	// blobArchiveTableSize defines the maximum size of blob archive data files.
+	blobArchiveTableSize = 2 * 1000 * 1000 * 1000
+
+	blobArchiveMetaTable     = "blob.meta"
+	blobArchiveSidecarsTable = "blob.sidecars"
+)
+
+// blobArchiveFreezerTableConfigs configures the settings for tables in the blob
+// archive freezer. Compression is disabled for the sidecars as blobs don't
+// compress well.
+var blobArchiveFreezerTableConfigs = map[string]freezerTableConfig{
+	blobArchiveMetaTable:     {noSnappy: true, prunable: true},
+	blobArchiveSidecarsTable: {noSnappy: true, prunable: true},
+}
+
 // The list of identifiers of ancient stores.
 var (
 	ChainFreezerName          = "chain"           // the folder name of chain segment ancient store.
@@ -145,3 +161,16 @@ func NewTrienodeFreezer(ancientDir string, verkle bool, readOnly bool) (ethdb.Re
 	}
 	return newResettableFreezer(name, "eth/db/trienode", readOnly, stateHistoryTableSize, trienodeFreezerTableConfigs)
 }
+
+// NewBlobArchiveFreezer initializes the ancient store for archived blob sidecars.
+//
+//   - if the empty directory is given, initializes the pure in-memory
+//     blob archive freezer (e.g. dev mode).
+//   - if non-empty directory is given, initializes the regular file-based
+//     blob archive freezer.
+func NewBlobArchiveFreezer(dir string, readOnly bool) (ethdb.AncientStore, error) {
+	if dir == "" {
+		return NewMemoryFreezer(readOnly, blobArchiveFreezerTableConfigs), nil
+	}
+	return NewFreezer(dir, "eth/db/blobarchive", readOnly, blobArchiveTableSize, blobArchiveFreezerTableConfigs)
+}
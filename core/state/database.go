@@ -154,6 +154,7 @@ type CachingDB struct {
 	snap          *snapshot.Tree
 	codeCache     *lru.SizeConstrainedCache[common.Hash, []byte]
 	codeSizeCache *lru.Cache[common.Hash, int]
+	fork          ForkSource // Remote state the local one is forked from, if any
 
 	// Transition-specific fields
 	TransitionStatePerRoot *lru.Cache[common.Hash, *overlay.TransitionState]
@@ -171,6 +172,17 @@ func NewDatabase(triedb *triedb.Database, snap *snapshot.Tree) *CachingDB {
 	}
 }
 
+// WithFork configures the state database to fork off the given remote state.
+// Accounts, storage slots and contract code not modified locally are resolved
+// from the source on demand.
+//
+// Forking is only supported for the Merkle-Patricia-tree in hash scheme, the
+// flat state readers are bypassed as they don't cover the remote state.
+func (db *CachingDB) WithFork(source ForkSource) *CachingDB {
+	db.fork = source
+	return db
+}
+
 // NewDatabaseForTesting is similar to NewDatabase, but it initializes the caching
 // db by using an ephemeral memory db with default config for testing.
 func NewDatabaseForTesting() *CachingDB {
@@ -179,6 +191,9 @@ func NewDatabaseForTesting() *CachingDB {
 
 // StateReader returns a state reader associated with the specified state root.
 func (db *CachingDB) StateReader(stateRoot common.Hash) (StateReader, error) {
+	if db.fork != nil {
+		return newForkReader(stateRoot, db.triedb, db.fork)
+	}
 	var readers []StateReader
 
 	// Configure the state reader using the standalone snapshot in hash mode.
@@ -218,7 +233,16 @@ func (db *CachingDB) Reader(stateRoot common.Hash) (Reader, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newReader(newCachingCodeReader(db.disk, db.codeCache, db.codeSizeCache), sr), nil
+	return newReader(db.codeReader(), sr), nil
+}
+
+// codeReader constructs a contract code reader sharing the caches of the database.
+func (db *CachingDB) codeReader() ContractCodeReaderWithStats {
+	cr := newCachingCodeReader(db.disk, db.codeCache, db.codeSizeCache)
+	if db.fork != nil {
+		return &forkCodeReader{cachingCodeReader: cr, source: db.fork}
+	}
+	return cr
 }
 
 // ReadersWithCacheStats creates a pair of state readers that share the same
@@ -231,8 +255,8 @@ func (db *CachingDB) ReadersWithCacheStats(stateRoot common.Hash) (ReaderWithSta
 	}
 	sr := newStateReaderWithCache(r)
 
-	ra := newReaderWithStats(sr, newCachingCodeReader(db.disk, db.codeCache, db.codeSizeCache))
-	rb := newReaderWithStats(sr, newCachingCodeReader(db.disk, db.codeCache, db.codeSizeCache))
+	ra := newReaderWithStats(sr, db.codeReader())
+	rb := newReaderWithStats(sr, db.codeReader())
 	return ra, rb, nil
 }
 
@@ -253,6 +277,9 @@ func (db *CachingDB) OpenTrie(root common.Hash) (Trie, error) {
 	if err != nil {
 		return nil, err
 	}
+	if db.fork != nil {
+		return &forkTrie{tr}, nil
+	}
 	return tr, nil
 }
 
@@ -265,6 +292,9 @@ func (db *CachingDB) OpenStorageTrie(stateRoot common.Hash, address common.Addre
 	if err != nil {
 		return nil, err
 	}
+	if db.fork != nil {
+		return &forkTrie{tr}, nil
+	}
 	return tr, nil
 }
 
@@ -301,6 +331,8 @@ func mustCopyTrie(t Trie) Trie {
 		return t.Copy()
 	case *transitiontrie.TransitionTrie:
 		return t.Copy()
+	case *forkTrie:
+		return &forkTrie{mustCopyTrie(t.Trie)}
 	default:
 		panic(fmt.Errorf("unknown trie type %T", t))
 	}
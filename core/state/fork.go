@@ -0,0 +1,205 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// ForkSource provides the state of a remote chain at a fixed block, which is
+// used as the base of a forked local state. The state is resolved lazily, so
+// implementations are expected to cache the retrieved data.
+//
+// ForkSource must be safe for concurrent access.
+type ForkSource interface {
+	// Account retrieves the remote account with the given address. The storage
+	// root of the returned account is ignored, a nil account is returned if it
+	// does not exist.
+	Account(addr common.Address) (*types.StateAccount, error)
+
+	// Storage retrieves the value of a remote storage slot.
+	Storage(addr common.Address, slot common.Hash) (common.Hash, error)
+
+	// Code retrieves the remote contract code of the given account.
+	Code(addr common.Address) ([]byte, error)
+}
+
+var (
+	// forkTombstoneCodeHash marks an account deleted in the local state, which
+	// must not be resolved from the fork source anymore.
+	forkTombstoneCodeHash = crypto.Keccak256Hash([]byte("fork tombstone"))
+
+	// forkTombstoneSlot marks a storage slot cleared in the local state. The
+	// value can't collide with a real slot, as those have their leading zeroes
+	// trimmed before being stored.
+	forkTombstoneSlot = []byte{0x00}
+)
+
+// forkReader implements StateReader, resolving the state from the local trie
+// first and falling back to the fork source for anything not modified locally.
+//
+// Accounts resolved from the fork source have an empty storage root, such that
+// only the locally modified slots end up in their local storage trie.
+type forkReader struct {
+	local  *trieReader
+	source ForkSource
+}
+
+// newForkReader constructs a state reader forking off the given source.
+func newForkReader(root common.Hash, db *triedb.Database, source ForkSource) (*forkReader, error) {
+	tr, err := newTrieReader(root, db)
+	if err != nil {
+		return nil, err
+	}
+	return &forkReader{local: tr, source: source}, nil
+}
+
+// Account implements StateReader, retrieving the account specified by the address.
+func (r *forkReader) Account(addr common.Address) (*types.StateAccount, error) {
+	account, err := r.local.Account(addr)
+	if err != nil {
+		return nil, err
+	}
+	if account != nil {
+		if common.BytesToHash(account.CodeHash) == forkTombstoneCodeHash {
+			return nil, nil
+		}
+		return account, nil
+	}
+	account, err = r.source.Account(addr)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	account.Root = types.EmptyRootHash
+	return account, nil
+}
+
+// Storage implements StateReader, retrieving the storage slot specified by the
+// address and slot key.
+func (r *forkReader) Storage(addr common.Address, key common.Hash) (common.Hash, error) {
+	ret, err := r.local.storage(addr, key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(ret) == 0 {
+		return r.source.Storage(addr, key)
+	}
+	var value common.Hash
+	value.SetBytes(ret) // tombstones are resolved as the empty slot
+	return value, nil
+}
+
+// forkCodeReader wraps a code reader, resolving the contract code not available
+// locally from the fork source.
+type forkCodeReader struct {
+	*cachingCodeReader
+	source ForkSource
+}
+
+// Code implements ContractCodeReader, retrieving a particular contract's code.
+func (r *forkCodeReader) Code(addr common.Address, codeHash common.Hash) ([]byte, error) {
+	code, err := r.cachingCodeReader.Code(addr, codeHash)
+	if err != nil || len(code) > 0 || codeHash == types.EmptyCodeHash {
+		return code, err
+	}
+	code, err = r.source.Code(addr)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+	if hash := crypto.Keccak256Hash(code); hash != codeHash {
+		return nil, fmt.Errorf("remote code hash mismatch: have %x, want %x", hash, codeHash)
+	}
+	r.codeCache.Add(codeHash, code)
+	r.codeSizeCache.Add(codeHash, len(code))
+	return code, nil
+}
+
+// CodeSize implements ContractCodeReader, retrieving a particular contracts code's size.
+func (r *forkCodeReader) CodeSize(addr common.Address, codeHash common.Hash) (int, error) {
+	if cached, ok := r.codeSizeCache.Get(codeHash); ok {
+		return cached, nil
+	}
+	code, err := r.Code(addr, codeHash)
+	if err != nil {
+		return 0, err
+	}
+	return len(code), nil
+}
+
+// Has returns the flag indicating whether the contract code with
+// specified address and hash exists or not.
+func (r *forkCodeReader) Has(addr common.Address, codeHash common.Hash) bool {
+	code, _ := r.Code(addr, codeHash)
+	return len(code) > 0
+}
+
+// forkTrie wraps a local trie of a forked state, recording deletions with
+// tombstones instead of removing the entries. Otherwise the deleted entries
+// would be resolved from the fork source again.
+type forkTrie struct {
+	Trie
+}
+
+// GetAccount implements Trie, hiding the tombstones of deleted accounts.
+func (t *forkTrie) GetAccount(address common.Address) (*types.StateAccount, error) {
+	account, err := t.Trie.GetAccount(address)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	if common.BytesToHash(account.CodeHash) == forkTombstoneCodeHash {
+		return nil, nil
+	}
+	return account, nil
+}
+
+// GetStorage implements Trie, hiding the tombstones of cleared slots.
+func (t *forkTrie) GetStorage(addr common.Address, key []byte) ([]byte, error) {
+	value, err := t.Trie.GetStorage(addr, key)
+	if err != nil || bytes.Equal(value, forkTombstoneSlot) {
+		return nil, err
+	}
+	return value, nil
+}
+
+// UpdateStorage implements Trie, replacing the deletion of a slot with a tombstone.
+func (t *forkTrie) UpdateStorage(addr common.Address, key, value []byte) error {
+	if len(value) == 0 {
+		value = forkTombstoneSlot
+	}
+	return t.Trie.UpdateStorage(addr, key, value)
+}
+
+// DeleteAccount implements Trie, replacing the account with a tombstone.
+func (t *forkTrie) DeleteAccount(address common.Address) error {
+	tombstone := types.NewEmptyStateAccount()
+	tombstone.CodeHash = forkTombstoneCodeHash.Bytes()
+	return t.Trie.UpdateAccount(address, tombstone, 0)
+}
+
+// DeleteStorage implements Trie, replacing the slot with a tombstone.
+func (t *forkTrie) DeleteStorage(addr common.Address, key []byte) error {
+	return t.Trie.UpdateStorage(addr, key, forkTombstoneSlot)
+}
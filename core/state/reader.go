@@ -397,13 +397,23 @@ func (r *trieReader) Account(addr common.Address) (*types.StateAccount, error) {
 // An error will be returned if the trie state is corrupted. An empty storage
 // slot will be returned if it's not existent in the trie.
 func (r *trieReader) Storage(addr common.Address, key common.Hash) (common.Hash, error) {
+	ret, err := r.storage(addr, key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	var value common.Hash
+	value.SetBytes(ret)
+	return value, nil
+}
+
+// storage retrieves the raw value of the storage slot from the trie.
+func (r *trieReader) storage(addr common.Address, key common.Hash) ([]byte, error) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
 	var (
 		tr    Trie
 		found bool
-		value common.Hash
 	)
 	if r.db.IsVerkle() {
 		tr = r.mainTrie
@@ -417,24 +427,19 @@ func (r *trieReader) Storage(addr common.Address, key common.Hash) (common.Hash,
 			if !ok {
 				_, err := r.account(addr)
 				if err != nil {
-					return common.Hash{}, err
+					return nil, err
 				}
 				root = r.subRoots[addr]
 			}
 			var err error
 			tr, err = trie.NewStateTrie(trie.StorageTrieID(r.root, crypto.Keccak256Hash(addr.Bytes()), root), r.db)
 			if err != nil {
-				return common.Hash{}, err
+				return nil, err
 			}
 			r.subTries[addr] = tr
 		}
 	}
-	ret, err := tr.GetStorage(addr, key.Bytes())
-	if err != nil {
-		return common.Hash{}, err
-	}
-	value.SetBytes(ret)
-	return value, nil
+	return tr.GetStorage(addr, key.Bytes())
 }
 
 // multiStateReader is the aggregation of a list of StateReader interface,
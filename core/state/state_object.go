@@ -520,7 +520,7 @@ func (s *stateObject) deepCopy(db *StateDB) *stateObject {
 		// Same thing for the transition tree, since the MPT is
 		// read-only.
 		obj.trie = db.trie
-	case *trie.StateTrie:
+	case *trie.StateTrie, *forkTrie:
 		obj.trie = mustCopyTrie(s.trie)
 	case nil:
 		// do nothing
@@ -0,0 +1,182 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package blobpool
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// archiveIndexBatch is the number of metadata entries read at once while
+// indexing the archive on startup.
+const archiveIndexBatch = 1024
+
+// errArchiveDisabled is returned if archived blobs are requested from a pool
+// without a configured archive retention.
+var errArchiveDisabled = errors.New("blob archive disabled")
+
+// ArchivedSidecar is the sidecar of a blob transaction retained in the archive
+// after the transaction was included and finalized.
+type ArchivedSidecar struct {
+	TxHash  common.Hash          // Hash of the owner transaction
+	TxIndex uint64               // Index of the owner transaction in the block
+	Sidecar *types.BlobTxSidecar // Blobs, commitments and proofs of the transaction
+}
+
+// archiveMeta is the metadata stored alongside the sidecars of a block.
+type archiveMeta struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// archive is an append-only store of the blob sidecars of finalized blocks,
+// retaining them for a configured number of blocks after the limbo dropped
+// them. The sidecars of each block are stored as a single freezer item, which
+// is indexed by block hash in memory.
+type archive struct {
+	store     ethdb.AncientStore
+	retention uint64 // Number of blocks to retain the sidecars for
+
+	metas []archiveMeta          // Metadata of the stored items, starting at the tail
+	index map[common.Hash]uint64 // Mappings from block hashes to item ids
+	lock  sync.RWMutex
+}
+
+// newArchive opens and indexes the blob sidecar archive.
+func newArchive(datadir string, retention uint64) (*archive, error) {
+	store, err := rawdb.NewBlobArchiveFreezer(datadir, false)
+	if err != nil {
+		return nil, err
+	}
+	a := &archive{
+		store:     store,
+		retention: retention,
+		index:     make(map[common.Hash]uint64),
+	}
+	tail, err := store.Tail()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	head, err := store.Ancients()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	for id := tail; id < head; {
+		blobs, err := rawdb.ReadBlobArchiveMetaList(store, id, min(head-id, archiveIndexBatch))
+		if err != nil {
+			store.Close()
+			return nil, err
+		}
+		for _, blob := range blobs {
+			var meta archiveMeta
+			if err := rlp.DecodeBytes(blob, &meta); err != nil {
+				store.Close()
+				return nil, err
+			}
+			a.metas = append(a.metas, meta)
+			a.index[meta.Hash] = id
+			id++
+		}
+	}
+	return a, nil
+}
+
+// Close closes down the underlying persistent store.
+func (a *archive) Close() error {
+	return a.store.Close()
+}
+
+// add stores the sidecars of a finalized block, evicting the blocks that fell
+// out of the retention window. Blocks must be added in ascending order.
+func (a *archive) add(number uint64, hash common.Hash, sidecars []*ArchivedSidecar) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.index[hash]; ok {
+		return nil // already archived before an unclean shutdown
+	}
+	if n := len(a.metas); n > 0 && a.metas[n-1].Number >= number {
+		return errors.New("archived block out of order")
+	}
+	meta, err := rlp.EncodeToBytes(&archiveMeta{Number: number, Hash: hash})
+	if err != nil {
+		return err
+	}
+	blob, err := rlp.EncodeToBytes(sidecars)
+	if err != nil {
+		return err
+	}
+	id, err := a.store.Ancients()
+	if err != nil {
+		return err
+	}
+	if err := rawdb.WriteBlobArchive(a.store, id, meta, blob); err != nil {
+		return err
+	}
+	a.metas = append(a.metas, archiveMeta{Number: number, Hash: hash})
+	a.index[hash] = id
+
+	// Evict the blocks outside of the retention window
+	if number < a.retention {
+		return nil
+	}
+	var (
+		cutoff = number - a.retention
+		stale  int
+	)
+	for stale < len(a.metas) && a.metas[stale].Number <= cutoff {
+		delete(a.index, a.metas[stale].Hash)
+		stale++
+	}
+	if stale == 0 {
+		return nil
+	}
+	a.metas = a.metas[stale:]
+	if _, err := a.store.TruncateTail(id + 1 - uint64(len(a.metas))); err != nil {
+		log.Error("Failed to evict archived blobs", "err", err)
+	}
+	return nil
+}
+
+// get retrieves the archived sidecars of a block, or nil if the block is unknown.
+func (a *archive) get(hash common.Hash) ([]*ArchivedSidecar, error) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	id, ok := a.index[hash]
+	if !ok {
+		return nil, nil
+	}
+	blob := rawdb.ReadBlobArchiveSidecars(a.store, id)
+	if blob == nil {
+		return nil, errors.New("archived blobs missing")
+	}
+	var sidecars []*ArchivedSidecar
+	if err := rlp.DecodeBytes(blob, &sidecars); err != nil {
+		return nil, err
+	}
+	return sidecars, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package blobpool
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tests that the archive serves the sidecars of archived blocks, evicts blocks
+// falling out of the retention window and retains its content across restarts.
+func TestArchive(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		key, _ = crypto.GenerateKey()
+	)
+	arch, err := newArchive(dir, 3)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	sidecars := make(map[uint64][]*ArchivedSidecar)
+	for number := uint64(1); number <= 5; number++ {
+		tx := makeTx(number, 1, 1, 1, key)
+		sidecars[number] = []*ArchivedSidecar{{TxHash: tx.Hash(), TxIndex: number, Sidecar: tx.BlobTxSidecar()}}
+		if err := arch.add(number, common.Hash{byte(number)}, sidecars[number]); err != nil {
+			t.Fatalf("failed to archive block %d: %v", number, err)
+		}
+	}
+	if err := arch.add(4, common.Hash{0xff}, nil); err == nil {
+		t.Fatal("archived block out of order")
+	}
+	check := func(store *archive) {
+		t.Helper()
+		for number := uint64(1); number <= 5; number++ {
+			have, err := store.get(common.Hash{byte(number)})
+			if err != nil {
+				t.Fatalf("failed to retrieve block %d: %v", number, err)
+			}
+			if number <= 2 {
+				if have != nil {
+					t.Errorf("block %d: evicted sidecars retrievable", number)
+				}
+				continue
+			}
+			if !reflect.DeepEqual(have, sidecars[number]) {
+				t.Errorf("block %d: sidecar mismatch", number)
+			}
+		}
+	}
+	check(arch)
+	arch.Close()
+
+	// Reopen the archive and ensure the content was retained.
+	arch, err = newArchive(dir, 3)
+	if err != nil {
+		t.Fatalf("failed to reopen archive: %v", err)
+	}
+	defer arch.Close()
+	check(arch)
+}
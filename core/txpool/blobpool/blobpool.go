@@ -91,6 +91,10 @@ const (
 	// but not yet finalized transaction blobs.
 	limboedTransactionStore = "limbo"
 
+	// archivedTransactionStore is the subfolder containing the retained blobs
+	// of finalized transactions.
+	archivedTransactionStore = "archive"
+
 	// storeVersion is the current slotter layout used for the billy.Database
 	// store.
 	storeVersion = 1
@@ -336,9 +340,10 @@ type BlobPool struct {
 	reserver       txpool.Reserver           // Address reserver to ensure exclusivity across subpools
 	hasPendingAuth func(common.Address) bool // Determine whether the specified address has a pending 7702-auth
 
-	store  billy.Database // Persistent data store for the tx metadata and blobs
-	stored uint64         // Useful data size of all transactions on disk
-	limbo  *limbo         // Persistent data store for the non-finalized blobs
+	store   billy.Database // Persistent data store for the tx metadata and blobs
+	stored  uint64         // Useful data size of all transactions on disk
+	limbo   *limbo         // Persistent data store for the non-finalized blobs
+	archive *archive       // Persistent data store for the finalized blobs (nil = disabled)
 
 	gapped       map[common.Address][]*types.Transaction // Transactions that are currently gapped (nonce too high)
 	gappedSource map[common.Hash]common.Address          // Source of gapped transactions to allow rechecking on inclusion
@@ -355,8 +360,11 @@ type BlobPool struct {
 	spent  map[common.Address]*uint256.Int  // Expenditure tracking for individual accounts
 	evict  *evictHeap                       // Heap of cheapest accounts for eviction when full
 
-	discoverFeed event.Feed // Event feed to send out new tx events on pool discovery (reorg excluded)
-	insertFeed   event.Feed // Event feed to send out new tx events on pool inclusion (reorg included)
+	discoverFeed event.Feed         // Event feed to send out new tx events on pool discovery (reorg excluded)
+	insertFeed   event.Feed         // Event feed to send out new tx events on pool inclusion (reorg included)
+	txEventFeed  txpool.TxEventFeed // Event feed to send out transaction lifecycle events
+
+	txEvents []txpool.TxEvent // Lifecycle events queued during the current operation
 
 	lock sync.RWMutex // Mutex protecting the pool during reorg handling
 }
@@ -398,8 +406,9 @@ func (p *BlobPool) Init(gasTip uint64, head *types.Header, reserver txpool.Reser
 	p.reserver = reserver
 
 	var (
-		queuedir string
-		limbodir string
+		queuedir   string
+		limbodir   string
+		archivedir string
 	)
 	if p.config.Datadir != "" {
 		queuedir = filepath.Join(p.config.Datadir, pendingTransactionStore)
@@ -410,6 +419,7 @@ func (p *BlobPool) Init(gasTip uint64, head *types.Header, reserver txpool.Reser
 		if err := os.MkdirAll(limbodir, 0700); err != nil {
 			return err
 		}
+		archivedir = filepath.Join(p.config.Datadir, archivedTransactionStore)
 	}
 	// Initialize the state with head block, or fallback to empty one in
 	// case the head state is not available (might occur when node is not
@@ -477,6 +487,14 @@ func (p *BlobPool) Init(gasTip uint64, head *types.Header, reserver txpool.Reser
 		p.Close()
 		return err
 	}
+	// If requested, retain the blobs beyond finality in the archive
+	if p.config.ArchiveRetention > 0 {
+		p.archive, err = newArchive(archivedir, p.config.ArchiveRetention)
+		if err != nil {
+			p.Close()
+			return err
+		}
+	}
 	// Set the configured gas tip, triggering a filtering of anything just loaded
 	basefeeGauge.Update(int64(basefee.Uint64()))
 	blobfeeGauge.Update(int64(blobfee.Uint64()))
@@ -488,6 +506,8 @@ func (p *BlobPool) Init(gasTip uint64, head *types.Header, reserver txpool.Reser
 	for p.stored > p.config.Datacap {
 		p.drop()
 	}
+	p.flushTxEvents()
+
 	// Update the metrics and return the constructed pool
 	datacapGauge.Update(int64(p.config.Datacap))
 	p.updateStorageMetrics()
@@ -502,6 +522,11 @@ func (p *BlobPool) Close() error {
 			errs = append(errs, err)
 		}
 	}
+	if p.archive != nil {
+		if err := p.archive.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	if err := p.store.Close(); err != nil {
 		errs = append(errs, err)
 	}
@@ -593,6 +618,12 @@ func (p *BlobPool) recheck(addr common.Address, inclusions map[common.Hash]uint6
 			p.stored -= uint64(txs[i].storageSize)
 			p.lookup.untrack(txs[i])
 
+			if gapped {
+				p.emitDropped(addr, txs[i], txpool.DropNonceGap)
+			} else {
+				p.emitStale(addr, txs[i], inclusions)
+			}
+
 			// Included transactions blobs need to be moved to the limbo
 			if filled && inclusions != nil {
 				p.offload(addr, txs[i].nonce, txs[i].id, inclusions)
@@ -633,6 +664,7 @@ func (p *BlobPool) recheck(addr common.Address, inclusions map[common.Hash]uint6
 			p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], txs[0].costCap)
 			p.stored -= uint64(txs[0].storageSize)
 			p.lookup.untrack(txs[0])
+			p.emitStale(addr, txs[0], inclusions)
 
 			// Included transactions blobs need to be moved to the limbo
 			if inclusions != nil {
@@ -711,6 +743,7 @@ func (p *BlobPool) recheck(addr common.Address, inclusions map[common.Hash]uint6
 			p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], txs[j].costCap)
 			p.stored -= uint64(txs[j].storageSize)
 			p.lookup.untrack(txs[j])
+			p.emitDropped(addr, txs[j], txpool.DropNonceGap)
 		}
 		txs = txs[:i]
 
@@ -749,6 +782,7 @@ func (p *BlobPool) recheck(addr common.Address, inclusions map[common.Hash]uint6
 			p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], last.costCap)
 			p.stored -= uint64(last.storageSize)
 			p.lookup.untrack(last)
+			p.emitDropped(addr, last, txpool.DropInsufficientFunds)
 		}
 		if len(txs) == 0 {
 			delete(p.index, addr)
@@ -789,6 +823,7 @@ func (p *BlobPool) recheck(addr common.Address, inclusions map[common.Hash]uint6
 			p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], last.costCap)
 			p.stored -= uint64(last.storageSize)
 			p.lookup.untrack(last)
+			p.emitDropped(addr, last, txpool.DropAccountLimit)
 		}
 		p.index[addr] = txs
 
@@ -844,6 +879,7 @@ func (p *BlobPool) Reset(oldHead, newHead *types.Header) {
 	p.lock.Lock()
 	resetwaitHist.Update(time.Since(waitStart).Nanoseconds())
 	defer p.lock.Unlock()
+	defer p.flushTxEvents()
 
 	defer func(start time.Time) {
 		resettimeHist.Update(time.Since(start).Nanoseconds())
@@ -862,7 +898,7 @@ func (p *BlobPool) Reset(oldHead, newHead *types.Header) {
 
 	// Run the reorg between the old and new head and figure out which accounts
 	// need to be rechecked and which transactions need to be readded
-	if reinject, inclusions := p.reorg(oldHead, newHead); reinject != nil {
+	if reinject, inclusions, blocks := p.reorg(oldHead, newHead); reinject != nil {
 		var adds []*types.Transaction
 		for addr, txs := range reinject {
 			// Blindly push all the lost transactions back into the pool
@@ -878,10 +914,20 @@ func (p *BlobPool) Reset(oldHead, newHead *types.Header) {
 		if len(adds) > 0 {
 			p.insertFeed.Send(core.NewTxsEvent{Txs: adds})
 		}
+		for i := range p.txEvents {
+			if p.txEvents[i].Type == txpool.TxEventIncluded {
+				p.txEvents[i].BlockHash = blocks[p.txEvents[i].BlockNumber]
+			}
+		}
 	}
-	// Flush out any blobs from limbo that are older than the latest finality
+	// Flush out any blobs from limbo that are older than the latest finality,
+	// retaining them in the archive if enabled
 	if p.chain.Config().IsCancun(newHead.Number, newHead.Time) {
-		p.limbo.finalize(p.chain.CurrentFinalBlock())
+		if p.archive != nil {
+			p.limbo.finalize(p.chain.CurrentFinalBlock(), p.archiveBlobs)
+		} else {
+			p.limbo.finalize(p.chain.CurrentFinalBlock(), nil)
+		}
 	}
 	// Reset the price heap for the new set of basefee/blobfee pairs
 	var (
@@ -898,23 +944,114 @@ func (p *BlobPool) Reset(oldHead, newHead *types.Header) {
 	p.updateStorageMetrics()
 }
 
+// archiveBlobs stores the blobs of the transactions finalized in the limbo into
+// the archive, ordered as included in the canonical chain.
+func (p *BlobPool) archiveBlobs(final *types.Header, txs map[uint64][]*types.Transaction) {
+	// Collect the blocks the transactions were included in, walking back from
+	// the finalized block.
+	oldest := final.Number.Uint64()
+	for number := range txs {
+		oldest = min(oldest, number)
+	}
+	var blocks []*types.Block
+	for hash, number := final.Hash(), final.Number.Uint64(); number >= oldest; number-- {
+		block := p.chain.GetBlock(hash, number)
+		if block == nil {
+			log.Warn("Finalized block unavailable for blob archival", "number", number, "hash", hash)
+			break
+		}
+		if _, ok := txs[number]; ok {
+			blocks = append(blocks, block)
+		}
+		if number == 0 {
+			break
+		}
+		hash = block.ParentHash()
+	}
+	// Archive the sidecars of the blocks in ascending order
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+
+		included := make(map[common.Hash]*types.Transaction)
+		for _, tx := range txs[block.NumberU64()] {
+			included[tx.Hash()] = tx
+		}
+		var sidecars []*ArchivedSidecar
+		for j, tx := range block.Transactions() {
+			if owner, ok := included[tx.Hash()]; ok {
+				sidecars = append(sidecars, &ArchivedSidecar{
+					TxHash:  tx.Hash(),
+					TxIndex: uint64(j),
+					Sidecar: owner.BlobTxSidecar(),
+				})
+			}
+		}
+		if len(sidecars) == 0 {
+			continue
+		}
+		if err := p.archive.add(block.NumberU64(), block.Hash(), sidecars); err != nil {
+			log.Error("Failed to archive finalized blobs", "number", block.Number(), "hash", block.Hash(), "err", err)
+		}
+	}
+}
+
+// GetBlobSidecars returns the sidecars of the blob transactions included in the
+// given block, either from the archive if the block is already finalized, or
+// from the limbo otherwise. Only transactions that went through the pool are
+// retained, the sidecars of any other transactions are missing from the result.
+func (p *BlobPool) GetBlobSidecars(block *types.Block) ([]*ArchivedSidecar, error) {
+	if p.archive == nil {
+		return nil, errArchiveDisabled
+	}
+	sidecars, err := p.archive.get(block.Hash())
+	if err != nil || sidecars != nil {
+		return sidecars, err
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for i, tx := range block.Transactions() {
+		if tx.Type() != types.BlobTxType {
+			continue
+		}
+		id, ok := p.limbo.index[tx.Hash()]
+		if !ok {
+			continue
+		}
+		item, err := p.limbo.get(id)
+		if err != nil {
+			return nil, err
+		}
+		if item.Block != block.NumberU64() {
+			continue // included in a reorged block
+		}
+		sidecars = append(sidecars, &ArchivedSidecar{
+			TxHash:  tx.Hash(),
+			TxIndex: uint64(i),
+			Sidecar: item.Tx.BlobTxSidecar(),
+		})
+	}
+	return sidecars, nil
+}
+
 // reorg assembles all the transactors and missing transactions between an old
 // and new head to figure out which account's tx set needs to be rechecked and
 // which transactions need to be requeued.
 //
 // The transactionblock inclusion infos are also returned to allow tracking any
-// just-included blocks by block number in the limbo.
-func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*types.Transaction, map[common.Hash]uint64) {
+// just-included blocks by block number in the limbo, along with the hashes of
+// the newly included blocks.
+func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*types.Transaction, map[common.Hash]uint64, map[uint64]common.Hash) {
 	// If the pool was not yet initialized, don't do anything
 	if oldHead == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 	// If the reorg is too deep, avoid doing it (will happen during snap sync)
 	oldNum := oldHead.Number.Uint64()
 	newNum := newHead.Number.Uint64()
 
 	if depth := uint64(math.Abs(float64(oldNum) - float64(newNum))); depth > 64 {
-		return nil, nil
+		return nil, nil, nil
 	}
 	// Reorg seems shallow enough to pull in all transactions into memory
 	var (
@@ -922,6 +1059,7 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 		discarded   = make(map[common.Address][]*types.Transaction)
 		included    = make(map[common.Address][]*types.Transaction)
 		inclusions  = make(map[common.Hash]uint64)
+		blocks      = make(map[uint64]common.Hash)
 
 		rem = p.chain.GetBlock(oldHead.Hash(), oldHead.Number.Uint64())
 		add = p.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64())
@@ -932,7 +1070,7 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 		// reorg caused by sync-reversion or explicit sethead back to an
 		// earlier block.
 		log.Warn("Blobpool reset with missing new head", "number", newHead.Number, "hash", newHead.Hash())
-		return nil, nil
+		return nil, nil, nil
 	}
 	if rem == nil {
 		// This can happen if a setHead is performed, where we simply discard
@@ -943,13 +1081,13 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 			// of setHead
 			log.Warn("Blobpool reset with missing old head",
 				"old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
-			return nil, nil
+			return nil, nil, nil
 		}
 		// If the reorg ended up on a lower number, it's indicative of setHead
 		// being the cause
 		log.Debug("Skipping blobpool reset caused by setHead",
 			"old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
-		return nil, nil
+		return nil, nil, nil
 	}
 	// Both old and new blocks exist, traverse through the progression chain
 	// and accumulate the transactors and transactions
@@ -962,7 +1100,7 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 		}
 		if rem = p.chain.GetBlock(rem.ParentHash(), rem.NumberU64()-1); rem == nil {
 			log.Error("Unrooted old chain seen by blobpool", "block", oldHead.Number, "hash", oldHead.Hash())
-			return nil, nil
+			return nil, nil, nil
 		}
 	}
 	for add.NumberU64() > rem.NumberU64() {
@@ -973,9 +1111,10 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 			inclusions[tx.Hash()] = add.NumberU64()
 			transactors[from] = struct{}{}
 		}
+		blocks[add.NumberU64()] = add.Hash()
 		if add = p.chain.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
 			log.Error("Unrooted new chain seen by blobpool", "block", newHead.Number, "hash", newHead.Hash())
-			return nil, nil
+			return nil, nil, nil
 		}
 	}
 	for rem.Hash() != add.Hash() {
@@ -987,7 +1126,7 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 		}
 		if rem = p.chain.GetBlock(rem.ParentHash(), rem.NumberU64()-1); rem == nil {
 			log.Error("Unrooted old chain seen by blobpool", "block", oldHead.Number, "hash", oldHead.Hash())
-			return nil, nil
+			return nil, nil, nil
 		}
 		for _, tx := range add.Transactions() {
 			from, _ := types.Sender(p.signer, tx)
@@ -996,9 +1135,10 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 			inclusions[tx.Hash()] = add.NumberU64()
 			transactors[from] = struct{}{}
 		}
+		blocks[add.NumberU64()] = add.Hash()
 		if add = p.chain.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
 			log.Error("Unrooted new chain seen by blobpool", "block", newHead.Number, "hash", newHead.Hash())
-			return nil, nil
+			return nil, nil, nil
 		}
 	}
 	// Generate the set of transactions per address to pull back into the pool,
@@ -1021,7 +1161,7 @@ func (p *BlobPool) reorg(oldHead, newHead *types.Header) (map[common.Address][]*
 			}
 		}
 	}
-	return reinject, inclusions
+	return reinject, inclusions, blocks
 }
 
 // reinject blindly pushes a transaction previously included in the chain - and
@@ -1094,6 +1234,7 @@ func (p *BlobPool) reinject(addr common.Address, txhash common.Hash) error {
 func (p *BlobPool) SetGasTip(tip *big.Int) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+	defer p.flushTxEvents()
 
 	// Store the new minimum gas tip
 	old := p.gasTip.Load()
@@ -1105,46 +1246,11 @@ func (p *BlobPool) SetGasTip(tip *big.Int) {
 		for addr, txs := range p.index {
 			for i, tx := range txs {
 				if tx.execTipCap.Cmp(newTip) < 0 {
-					// Drop the offending transaction
-					var (
-						ids    = []uint64{tx.id}
-						nonces = []uint64{tx.nonce}
-					)
-					p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], txs[i].costCap)
-					p.stored -= uint64(tx.storageSize)
-					p.lookup.untrack(tx)
-					txs[i] = nil
-
-					// Drop everything afterwards, no gaps allowed
-					for j, tx := range txs[i+1:] {
-						ids = append(ids, tx.id)
-						nonces = append(nonces, tx.nonce)
-
-						p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], tx.costCap)
-						p.stored -= uint64(tx.storageSize)
-						p.lookup.untrack(tx)
-						txs[i+1+j] = nil
-					}
-					// Clear out the dropped transactions from the index
-					if i > 0 {
-						p.index[addr] = txs[:i]
-						heap.Fix(p.evict, p.evict.index[addr])
-					} else {
-						delete(p.index, addr)
-						delete(p.spent, addr)
-
-						heap.Remove(p.evict, p.evict.index[addr])
-						p.reserver.Release(addr)
-					}
-					// Clear out the transactions from the data store
+					// Drop the offending transaction and everything afterwards
+					ids, nonces := p.dropFrom(addr, i, txpool.DropUnderpriced)
+
 					log.Warn("Dropping underpriced blob transaction", "from", addr, "rejected", tx.nonce, "tip", tx.execTipCap, "want", tip, "drop", nonces, "ids", ids)
 					dropUnderpricedMeter.Mark(int64(len(ids)))
-
-					for _, id := range ids {
-						if err := p.store.Delete(id); err != nil {
-							log.Error("Failed to delete dropped transaction", "id", id, "err", err)
-						}
-					}
 					break
 				}
 			}
@@ -1155,6 +1261,76 @@ func (p *BlobPool) SetGasTip(tip *big.Int) {
 	p.updateStorageMetrics()
 }
 
+// dropFrom removes the transaction at the given position of an account, along
+// with all subsequent ones as the pool allows no nonce gaps. The returned ids
+// and nonces of the removed transactions are meant for logging.
+//
+// Note, this method assumes the pool lock is held!
+func (p *BlobPool) dropFrom(addr common.Address, i int, reason txpool.DropReason) ([]uint64, []uint64) {
+	var (
+		txs    = p.index[addr]
+		ids    []uint64
+		nonces []uint64
+	)
+	for j, tx := range txs[i:] {
+		ids = append(ids, tx.id)
+		nonces = append(nonces, tx.nonce)
+
+		p.spent[addr] = new(uint256.Int).Sub(p.spent[addr], tx.costCap)
+		p.stored -= uint64(tx.storageSize)
+		p.lookup.untrack(tx)
+		if j == 0 {
+			p.emitDropped(addr, tx, reason)
+		} else {
+			p.emitDropped(addr, tx, txpool.DropNonceGap)
+		}
+		txs[i+j] = nil
+	}
+	// Clear out the dropped transactions from the index
+	if i > 0 {
+		p.index[addr] = txs[:i]
+		heap.Fix(p.evict, p.evict.index[addr])
+	} else {
+		delete(p.index, addr)
+		delete(p.spent, addr)
+
+		heap.Remove(p.evict, p.evict.index[addr])
+		p.reserver.Release(addr)
+	}
+	// Clear out the transactions from the data store
+	for _, id := range ids {
+		if err := p.store.Delete(id); err != nil {
+			log.Error("Failed to delete dropped transaction", "id", id, "err", err)
+		}
+	}
+	return ids, nonces
+}
+
+// Drop implements txpool.SubPool, removing a transaction from the pool along
+// with all subsequent transactions of the same sender.
+func (p *BlobPool) Drop(hash common.Hash, reason txpool.DropReason) bool {
+	tx := p.Get(hash)
+	if tx == nil {
+		return false
+	}
+	from, _ := types.Sender(p.signer, tx) // already validated
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	defer p.flushTxEvents()
+
+	for i, meta := range p.index[from] {
+		if meta.hash == hash {
+			ids, nonces := p.dropFrom(from, i, reason)
+			log.Debug("Dropped blob transaction", "from", from, "reason", reason, "drop", nonces, "ids", ids)
+
+			p.updateStorageMetrics()
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateTxBasics checks whether a transaction is valid according to the consensus
 // rules, but does not check state-dependent validation such as sufficient balance.
 // This check is meant as an early check which only needs to be performed once,
@@ -1501,6 +1677,7 @@ func (p *BlobPool) add(tx *types.Transaction) (err error) {
 	p.lock.Lock()
 	addwaitHist.Update(time.Since(waitStart).Nanoseconds())
 	defer p.lock.Unlock()
+	defer p.flushTxEvents()
 
 	defer func(start time.Time) {
 		addtimeHist.Update(time.Since(start).Nanoseconds())
@@ -1611,6 +1788,8 @@ func (p *BlobPool) addLocked(tx *types.Transaction, checkGapped bool) (err error
 		p.lookup.untrack(prev)
 		p.lookup.track(meta)
 		p.stored += uint64(meta.storageSize) - uint64(prev.storageSize)
+
+		p.emitTxEvent(txpool.TxEventReplaced, from, prev).ReplacedBy = meta.hash
 	} else {
 		// Transaction extends previously scheduled ones
 		p.index[from] = append(p.index[from], meta)
@@ -1669,6 +1848,8 @@ func (p *BlobPool) addLocked(tx *types.Transaction, checkGapped bool) (err error
 			heap.Fix(p.evict, p.evict.index[from])
 		}
 	}
+	p.emitTxEvent(txpool.TxEventAdded, from, meta)
+
 	// If the pool went over the allowed data limit, evict transactions until
 	// we're again below the threshold
 	for p.stored > p.config.Datacap {
@@ -1761,6 +1942,7 @@ func (p *BlobPool) drop() {
 	}
 	p.stored -= uint64(drop.storageSize)
 	p.lookup.untrack(drop)
+	p.emitDropped(from, drop, txpool.DropPoolFull)
 
 	// Remove the transaction from the pool's eviction heap:
 	//   - If the entire account was dropped, pop off the address
@@ -25,6 +25,10 @@ type Config struct {
 	Datadir   string // Data directory containing the currently executable blobs
 	Datacap   uint64 // Soft-cap of database storage (hard cap is larger due to overhead)
 	PriceBump uint64 // Minimum price bump percentage to replace an already existing nonce
+
+	// ArchiveRetention is the number of blocks for which the blobs of finalized
+	// transactions are retained in the archive (0 = archive disabled).
+	ArchiveRetention uint64
 }
 
 // DefaultConfig contains the default configurations for the transaction pool.
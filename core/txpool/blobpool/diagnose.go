@@ -0,0 +1,93 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package blobpool
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// Diagnose reports the status of the pooled transactions of an account along
+// with the reasons holding them back.
+func (p *BlobPool) Diagnose(addr common.Address) []*txpool.TxDiagnosis {
+	// We need a write lock here, since state.GetBalance might write the cache.
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	metas, gapped := p.index[addr], p.gapped[addr]
+	if len(metas) == 0 && len(gapped) == 0 {
+		return nil
+	}
+	var (
+		balance = p.state.GetBalance(addr)
+		gasTip  = p.gasTip.Load()
+		cost    = new(uint256.Int)
+		diags   = make([]*txpool.TxDiagnosis, 0, len(metas)+len(gapped))
+	)
+	for _, meta := range metas {
+		cost.Add(cost, meta.costCap)
+
+		diag := &txpool.TxDiagnosis{
+			Hash:   meta.hash,
+			Nonce:  meta.nonce,
+			Type:   types.BlobTxType,
+			Status: txpool.TxStatusPending,
+			Cost:   cost.Clone(),
+		}
+		if cost.Gt(balance) {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerInsufficientFunds)
+		}
+		if meta.execTipCap.Lt(gasTip) {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerUnderpriced)
+		}
+		diags = append(diags, diag)
+	}
+	// Gapped transactions wait in the reorder buffer for the missing nonces in
+	// arrival order, they need the account's remaining slots to be added.
+	gapped = slices.Clone(gapped)
+	slices.SortStableFunc(gapped, func(a, b *types.Transaction) int {
+		return cmp.Compare(a.Nonce(), b.Nonce())
+	})
+	for i, tx := range gapped {
+		cost.Add(cost, uint256.MustFromBig(tx.Cost()))
+
+		diag := &txpool.TxDiagnosis{
+			Hash:     tx.Hash(),
+			Nonce:    tx.Nonce(),
+			Type:     types.BlobTxType,
+			Status:   txpool.TxStatusQueued,
+			Cost:     cost.Clone(),
+			Blockers: []txpool.TxBlocker{txpool.TxBlockerNonceGap},
+		}
+		if len(metas)+i >= maxTxsPerAccount {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerAccountLimit)
+		}
+		if cost.Gt(balance) {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerInsufficientFunds)
+		}
+		if tx.GasTipCapIntCmp(gasTip.ToBig()) < 0 {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerUnderpriced)
+		}
+		diags = append(diags, diag)
+	}
+	return diags
+}
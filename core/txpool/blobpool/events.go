@@ -0,0 +1,68 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package blobpool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// SubscribeTxEvents registers a subscription for transaction lifecycle events.
+func (p *BlobPool) SubscribeTxEvents(ch chan<- []txpool.TxEvent) event.Subscription {
+	return p.txEventFeed.Subscribe(ch)
+}
+
+// emitTxEvent queues a lifecycle event of a pooled transaction. The returned
+// event may be amended by the caller until the next event is queued.
+//
+// Note, this method assumes the pool lock is held!
+func (p *BlobPool) emitTxEvent(typ txpool.TxEventType, from common.Address, meta *blobTxMeta) *txpool.TxEvent {
+	p.txEvents = append(p.txEvents, txpool.TxEvent{
+		Type:  typ,
+		Hash:  meta.hash,
+		From:  from,
+		Nonce: meta.nonce,
+	})
+	return &p.txEvents[len(p.txEvents)-1]
+}
+
+// emitDropped queues the event of a transaction dropped from the pool.
+func (p *BlobPool) emitDropped(from common.Address, meta *blobTxMeta, reason txpool.DropReason) {
+	p.emitTxEvent(txpool.TxEventDropped, from, meta).Reason = reason
+}
+
+// emitStale queues the event of a transaction whose nonce was consumed on chain,
+// either by the transaction itself or by a different one.
+func (p *BlobPool) emitStale(from common.Address, meta *blobTxMeta, inclusions map[common.Hash]uint64) {
+	if number, ok := inclusions[meta.hash]; ok {
+		p.emitTxEvent(txpool.TxEventIncluded, from, meta).BlockNumber = number
+		return
+	}
+	p.emitDropped(from, meta, txpool.DropNonceTooLow)
+}
+
+// flushTxEvents hands the queued lifecycle events over for delivery. The feed
+// never blocks, so the events are handed over while holding the pool lock.
+func (p *BlobPool) flushTxEvents() {
+	if len(p.txEvents) == 0 {
+		return
+	}
+	events := p.txEvents
+	p.txEvents = nil
+	p.txEventFeed.Send(events)
+}
@@ -122,14 +122,35 @@ func (l *limbo) parseBlob(id uint64, data []byte) error {
 	return nil
 }
 
-// finalize evicts all blobs belonging to a recently finalized block or older.
-func (l *limbo) finalize(final *types.Header) {
+// finalize evicts all blobs belonging to a recently finalized block or older. If
+// an archiver is given, the evicted transactions are handed over to it first,
+// grouped by their inclusion block.
+func (l *limbo) finalize(final *types.Header, archive func(final *types.Header, txs map[uint64][]*types.Transaction)) {
 	// Just in case there's no final block yet (network not yet merged, weird
 	// restart, sethead, etc), fail gracefully.
 	if final == nil {
 		log.Warn("Nil finalized block cannot evict old blobs")
 		return
 	}
+	if archive != nil {
+		finalized := make(map[uint64][]*types.Transaction)
+		for block, ids := range l.groups {
+			if block > final.Number.Uint64() {
+				continue
+			}
+			for id := range ids {
+				item, err := l.get(id)
+				if err != nil {
+					log.Error("Failed to retrieve finalized blob", "block", block, "id", id, "err", err)
+					continue
+				}
+				finalized[block] = append(finalized[block], item.Tx)
+			}
+		}
+		if len(finalized) > 0 {
+			archive(final, finalized)
+		}
+	}
 	for block, ids := range l.groups {
 		if block > final.Number.Uint64() {
 			continue
@@ -216,9 +237,8 @@ func (l *limbo) update(txhash common.Hash, block uint64) {
 	log.Trace("Blob transaction updated in limbo", "tx", txhash, "old-block", item.Block, "new-block", block)
 }
 
-// getAndDrop retrieves a blob item from the limbo store and deletes it both from
-// the store and indices.
-func (l *limbo) getAndDrop(id uint64) (*limboBlob, error) {
+// get retrieves a blob item from the limbo store.
+func (l *limbo) get(id uint64) (*limboBlob, error) {
 	data, err := l.store.Get(id)
 	if err != nil {
 		return nil, err
@@ -227,6 +247,16 @@ func (l *limbo) getAndDrop(id uint64) (*limboBlob, error) {
 	if err = rlp.DecodeBytes(data, item); err != nil {
 		return nil, err
 	}
+	return item, nil
+}
+
+// getAndDrop retrieves a blob item from the limbo store and deletes it both from
+// the store and indices.
+func (l *limbo) getAndDrop(id uint64) (*limboBlob, error) {
+	item, err := l.get(id)
+	if err != nil {
+		return nil, err
+	}
 	delete(l.index, item.TxHash)
 	delete(l.groups[item.Block], id)
 	if len(l.groups[item.Block]) == 0 {
@@ -0,0 +1,233 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bundlepool implements a pool of transaction bundles to be included
+// atomically by the local miner.
+package bundlepool
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// maxBundles is the maximum number of bundles the pool holds across all
+	// targeted blocks.
+	maxBundles = 1024
+
+	// maxBundleTxs is the maximum number of transactions in a single bundle.
+	maxBundleTxs = 64
+
+	// maxBlockDistance is the maximum number of blocks ahead of the current head
+	// a bundle may target.
+	maxBlockDistance = 32
+)
+
+var (
+	// ErrEmptyBundle is returned if a bundle contains no transactions.
+	ErrEmptyBundle = errors.New("bundle contains no transactions")
+
+	// ErrBundleTooLarge is returned if a bundle contains too many transactions.
+	ErrBundleTooLarge = fmt.Errorf("bundle exceeds %d transactions", maxBundleTxs)
+
+	// ErrBundleBlobTx is returned if a bundle contains a blob transaction.
+	ErrBundleBlobTx = errors.New("blob transactions are not supported in bundles")
+
+	// ErrBundleStale is returned if a bundle targets a block already on chain.
+	ErrBundleStale = errors.New("bundle targets a past block")
+
+	// ErrBundleTooFar is returned if a bundle targets a block too far ahead of
+	// the current head.
+	ErrBundleTooFar = fmt.Errorf("bundle targets a block more than %d blocks ahead", maxBlockDistance)
+
+	// ErrBundleKnown is returned if the bundle is already in the pool.
+	ErrBundleKnown = errors.New("bundle already known")
+
+	// ErrBundlePoolFull is returned if the pool can't accept more bundles.
+	ErrBundlePoolFull = errors.New("bundle pool is full")
+)
+
+var bundleGauge = metrics.NewRegisteredGauge("txpool/bundles", nil)
+
+// Bundle is an ordered list of transactions to be included in the given block
+// as a whole, or not at all.
+type Bundle struct {
+	Txs               []*types.Transaction // Transactions to include in order
+	BlockNumber       uint64               // Number of the block to include the bundle in
+	RevertingTxHashes []common.Hash        // Transactions allowed to revert without dropping the bundle
+}
+
+// Hash returns the identifier of the bundle, the hash of its transaction hashes.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// MayRevert returns whether the transaction with the given hash is allowed to
+// revert without invalidating the bundle.
+func (b *Bundle) MayRevert(hash common.Hash) bool {
+	return slices.Contains(b.RevertingTxHashes, hash)
+}
+
+// BlockChain defines the minimal set of methods needed to back a bundle pool.
+type BlockChain interface {
+	// CurrentBlock returns the current head of the chain.
+	CurrentBlock() *types.Header
+
+	// SubscribeChainHeadEvent subscribes to new blocks being added to the chain.
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// BundlePool holds the bundles submitted for inclusion in upcoming blocks. It
+// does not validate the contained transactions beyond their shape, the miner
+// simulates each bundle on top of the block being built and discards it if any
+// of its transactions fails.
+type BundlePool struct {
+	chain BlockChain
+
+	bundles map[uint64][]*Bundle // Bundles by targeted block number, in arrival order
+	known   map[common.Hash]struct{}
+	lock    sync.RWMutex
+
+	headSub event.Subscription // Subscription to the new chain heads, pruning the pool
+	term    chan struct{}      // Termination channel to detect a closed pool
+}
+
+// New creates a new bundle pool tracking the given chain.
+func New(chain BlockChain) *BundlePool {
+	var (
+		headCh = make(chan core.ChainHeadEvent, 1)
+		pool   = &BundlePool{
+			chain:   chain,
+			bundles: make(map[uint64][]*Bundle),
+			known:   make(map[common.Hash]struct{}),
+			headSub: chain.SubscribeChainHeadEvent(headCh),
+			term:    make(chan struct{}),
+		}
+	)
+	go pool.loop(headCh)
+	return pool
+}
+
+// loop drops the bundles targeting blocks which were added to the chain, until
+// the pool is closed.
+func (p *BundlePool) loop(headCh <-chan core.ChainHeadEvent) {
+	defer close(p.term)
+
+	for {
+		select {
+		case ev := <-headCh:
+			p.lock.Lock()
+			p.prune(ev.Header.Number.Uint64())
+			p.lock.Unlock()
+
+		case <-p.headSub.Err():
+			return
+		}
+	}
+}
+
+// Close stops tracking the chain and waits for the pruning loop to terminate.
+func (p *BundlePool) Close() {
+	p.headSub.Unsubscribe()
+	<-p.term
+}
+
+// Add inserts a bundle into the pool, to be considered when building the block
+// it targets.
+func (p *BundlePool) Add(bundle *Bundle) error {
+	switch {
+	case len(bundle.Txs) == 0:
+		return ErrEmptyBundle
+	case len(bundle.Txs) > maxBundleTxs:
+		return ErrBundleTooLarge
+	}
+	for _, tx := range bundle.Txs {
+		if tx.Type() == types.BlobTxType {
+			return ErrBundleBlobTx
+		}
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	head := p.chain.CurrentBlock().Number.Uint64()
+	switch {
+	case bundle.BlockNumber <= head:
+		return ErrBundleStale
+	case bundle.BlockNumber > head+maxBlockDistance:
+		return ErrBundleTooFar
+	}
+	p.prune(head)
+
+	hash := bundle.Hash()
+	if _, ok := p.known[hash]; ok {
+		return ErrBundleKnown
+	}
+	if len(p.known) >= maxBundles {
+		return ErrBundlePoolFull
+	}
+	p.known[hash] = struct{}{}
+	p.bundles[bundle.BlockNumber] = append(p.bundles[bundle.BlockNumber], bundle)
+	bundleGauge.Update(int64(len(p.known)))
+
+	log.Debug("Added transaction bundle", "hash", hash, "block", bundle.BlockNumber, "txs", len(bundle.Txs))
+	return nil
+}
+
+// Bundles returns the bundles targeting the given block, in arrival order.
+func (p *BundlePool) Bundles(number uint64) []*Bundle {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return slices.Clone(p.bundles[number])
+}
+
+// Len returns the number of bundles in the pool.
+func (p *BundlePool) Len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return len(p.known)
+}
+
+// prune removes the bundles targeting blocks up to and including the given head.
+//
+// Note, this method assumes the pool lock is held!
+func (p *BundlePool) prune(head uint64) {
+	for number, bundles := range p.bundles {
+		if number > head {
+			continue
+		}
+		for _, bundle := range bundles {
+			delete(p.known, bundle.Hash())
+		}
+		delete(p.bundles, number)
+	}
+	bundleGauge.Update(int64(len(p.known)))
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bundlepool
+
+import (
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// testChain is a mock chain whose head can be advanced by the tests.
+type testChain struct {
+	head atomic.Uint64
+	feed event.Feed
+}
+
+func (c *testChain) CurrentBlock() *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(c.head.Load())}
+}
+
+func (c *testChain) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+// setHead advances the chain to the given block, notifying the subscribers.
+func (c *testChain) setHead(number uint64) {
+	c.head.Store(number)
+	c.feed.Send(core.ChainHeadEvent{Header: c.CurrentBlock()})
+}
+
+func newTestPool(t *testing.T) (*BundlePool, *testChain) {
+	chain := new(testChain)
+	pool := New(chain)
+	t.Cleanup(pool.Close)
+	return pool, chain
+}
+
+// newBundle creates a bundle of a single transaction with the given nonce.
+func newBundle(number uint64, nonce uint64) *Bundle {
+	return &Bundle{
+		Txs:         []*types.Transaction{types.NewTx(&types.LegacyTx{Nonce: nonce})},
+		BlockNumber: number,
+	}
+}
+
+// Tests that bundles are checked for their shape and target block.
+func TestAdd(t *testing.T) {
+	pool, chain := newTestPool(t)
+	chain.setHead(10)
+
+	tests := []struct {
+		bundle *Bundle
+		err    error
+	}{
+		{&Bundle{BlockNumber: 11}, ErrEmptyBundle},
+		{&Bundle{Txs: make([]*types.Transaction, maxBundleTxs+1), BlockNumber: 11}, ErrBundleTooLarge},
+		{&Bundle{Txs: []*types.Transaction{types.NewTx(&types.BlobTx{})}, BlockNumber: 11}, ErrBundleBlobTx},
+		{newBundle(10, 0), ErrBundleStale},
+		{newBundle(11+maxBlockDistance, 0), ErrBundleTooFar},
+		{newBundle(10+maxBlockDistance, 0), nil},
+		{newBundle(11, 1), nil},
+	}
+	for i, test := range tests {
+		if err := pool.Add(test.bundle); !errors.Is(err, test.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, test.err)
+		}
+	}
+	if n := len(pool.Bundles(11)); n != 1 {
+		t.Errorf("wrong number of bundles for block 11: have %d, want 1", n)
+	}
+}
+
+// Tests that a bundle can't be added twice, but can be resubmitted once it was
+// pruned.
+func TestAddKnown(t *testing.T) {
+	pool, _ := newTestPool(t)
+
+	if err := pool.Add(newBundle(1, 0)); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if err := pool.Add(newBundle(1, 0)); !errors.Is(err, ErrBundleKnown) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrBundleKnown)
+	}
+	// The transactions identify the bundle, regardless of the targeted block.
+	if err := pool.Add(newBundle(2, 0)); !errors.Is(err, ErrBundleKnown) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrBundleKnown)
+	}
+	if err := pool.Add(newBundle(1, 1)); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if pool.Len() != 2 {
+		t.Fatalf("wrong number of bundles: have %d, want 2", pool.Len())
+	}
+}
+
+// Tests that the pool rejects bundles once full, until space is freed by
+// pruning.
+func TestAddFull(t *testing.T) {
+	pool, chain := newTestPool(t)
+
+	for i := 0; i < maxBundles; i++ {
+		if err := pool.Add(newBundle(1+uint64(i%2), uint64(i))); err != nil {
+			t.Fatalf("bundle %d: failed to add: %v", i, err)
+		}
+	}
+	if err := pool.Add(newBundle(2, maxBundles)); !errors.Is(err, ErrBundlePoolFull) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrBundlePoolFull)
+	}
+	chain.setHead(1)
+	waitLen(t, pool, maxBundles/2)
+
+	if err := pool.Add(newBundle(2, maxBundles)); err != nil {
+		t.Fatalf("failed to add bundle after pruning: %v", err)
+	}
+}
+
+// Tests that the bundles targeting blocks added to the chain are dropped on new
+// heads, without waiting for further bundles to be added.
+func TestPrune(t *testing.T) {
+	pool, chain := newTestPool(t)
+
+	for number := uint64(1); number <= 3; number++ {
+		if err := pool.Add(newBundle(number, number)); err != nil {
+			t.Fatalf("failed to add bundle: %v", err)
+		}
+	}
+	chain.setHead(2)
+	waitLen(t, pool, 1)
+
+	if n := len(pool.Bundles(2)); n != 0 {
+		t.Fatalf("bundles of included block retained: %d", n)
+	}
+	if n := len(pool.Bundles(3)); n != 1 {
+		t.Fatalf("bundles of future block dropped: have %d, want 1", n)
+	}
+	// Pruned bundles may be resubmitted for a later block.
+	if err := pool.Add(newBundle(3, 1)); err != nil {
+		t.Fatalf("failed to resubmit pruned bundle: %v", err)
+	}
+}
+
+// waitLen waits for the pool to hold the given number of bundles, as pruning on
+// new heads is asynchronous.
+func waitLen(t *testing.T, pool *BundlePool, want int) {
+	t.Helper()
+
+	for deadline := time.Now().Add(time.Second); pool.Len() != want; {
+		if time.Now().After(deadline) {
+			t.Fatalf("wrong number of bundles: have %d, want %d", pool.Len(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
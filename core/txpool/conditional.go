@@ -0,0 +1,222 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// KnownAccount is the expected storage of an account in a transaction condition,
+// given either by the storage root or by the values of individual slots.
+type KnownAccount struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// TxConditional is the set of conditions a transaction is only valid under. All
+// set bounds are inclusive.
+type TxConditional struct {
+	KnownAccounts  map[common.Address]KnownAccount
+	BlockNumberMin *uint64
+	BlockNumberMax *uint64
+	TimestampMin   *uint64
+	TimestampMax   *uint64
+}
+
+// Validate checks the sanity of the condition itself.
+func (c *TxConditional) Validate() error {
+	if c.BlockNumberMin != nil && c.BlockNumberMax != nil && *c.BlockNumberMin > *c.BlockNumberMax {
+		return errors.New("block number range is empty")
+	}
+	if c.TimestampMin != nil && c.TimestampMax != nil && *c.TimestampMin > *c.TimestampMax {
+		return errors.New("timestamp range is empty")
+	}
+	for addr, account := range c.KnownAccounts {
+		if account.StorageRoot != nil && len(account.StorageSlots) > 0 {
+			return fmt.Errorf("account %x has both storage root and slots", addr)
+		}
+	}
+	return nil
+}
+
+// Expired returns whether the condition can't hold anymore for any block after
+// the given head.
+func (c *TxConditional) Expired(head *types.Header) bool {
+	if c.BlockNumberMax != nil && *c.BlockNumberMax <= head.Number.Uint64() {
+		return true
+	}
+	return c.TimestampMax != nil && *c.TimestampMax <= head.Time
+}
+
+// Check verifies the condition for inclusion in a block with the given header on
+// top of the given state. The storage roots of the accounts in the state must be
+// up to date, see CheckState.
+func (c *TxConditional) Check(header *types.Header, statedb *state.StateDB) error {
+	number := header.Number.Uint64()
+	if c.BlockNumberMin != nil && number < *c.BlockNumberMin {
+		return fmt.Errorf("%w: block number %d below minimum %d", ErrConditionFailed, number, *c.BlockNumberMin)
+	}
+	if c.BlockNumberMax != nil && number > *c.BlockNumberMax {
+		return fmt.Errorf("%w: block number %d above maximum %d", ErrConditionFailed, number, *c.BlockNumberMax)
+	}
+	if c.TimestampMin != nil && header.Time < *c.TimestampMin {
+		return fmt.Errorf("%w: timestamp %d below minimum %d", ErrConditionFailed, header.Time, *c.TimestampMin)
+	}
+	if c.TimestampMax != nil && header.Time > *c.TimestampMax {
+		return fmt.Errorf("%w: timestamp %d above maximum %d", ErrConditionFailed, header.Time, *c.TimestampMax)
+	}
+	return c.CheckState(statedb)
+}
+
+// CheckState verifies the expected storage of the known accounts.
+func (c *TxConditional) CheckState(statedb *state.StateDB) error {
+	for addr, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			if root := statedb.GetStorageRoot(addr); root != *account.StorageRoot {
+				return fmt.Errorf("%w: storage root of %x is %x, want %x", ErrConditionFailed, addr, root, *account.StorageRoot)
+			}
+			continue
+		}
+		for slot, want := range account.StorageSlots {
+			if have := statedb.GetState(addr, slot); have != want {
+				return fmt.Errorf("%w: storage slot %x of %x is %x, want %x", ErrConditionFailed, slot, addr, have, want)
+			}
+		}
+	}
+	return nil
+}
+
+// HasStorageRoots returns whether the condition refers to any storage roots.
+func (c *TxConditional) HasStorageRoots() bool {
+	for _, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// AddConditional inserts a transaction into the pool which may only be included
+// in blocks satisfying the given condition. The condition is verified against
+// the current head right away, and rechecked on every head change, dropping the
+// transaction once it can't hold anymore. As peers can't enforce the condition,
+// the transaction is never propagated to the network.
+func (p *TxPool) AddConditional(tx *types.Transaction, cond *TxConditional) error {
+	if err := cond.Validate(); err != nil {
+		return err
+	}
+	head := p.chain.CurrentBlock()
+	if cond.Expired(head) {
+		return fmt.Errorf("%w: expired", ErrConditionFailed)
+	}
+	statedb, err := p.chain.StateAt(head.Root)
+	if err != nil {
+		return err
+	}
+	if err := cond.CheckState(statedb); err != nil {
+		return err
+	}
+	// Attach the condition before adding the transaction, the network layer will
+	// be notified about its arrival right away.
+	hash := tx.Hash()
+
+	p.conditionalLock.Lock()
+	prev := p.conditionals[hash]
+	p.conditionals[hash] = cond
+	p.conditionalLock.Unlock()
+
+	if err := p.Add([]*types.Transaction{tx}, false)[0]; err != nil {
+		p.conditionalLock.Lock()
+		if prev != nil {
+			p.conditionals[hash] = prev
+		} else {
+			delete(p.conditionals, hash)
+		}
+		p.conditionalLock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Conditional returns the condition of the transaction with the given hash, or
+// nil if the transaction is unconditional.
+func (p *TxPool) Conditional(hash common.Hash) *TxConditional {
+	p.conditionalLock.RLock()
+	defer p.conditionalLock.RUnlock()
+
+	return p.conditionals[hash]
+}
+
+// recheckConditionals drops the conditional transactions whose condition can't
+// hold anymore on top of the given head, and forgets about the ones that left
+// the pool otherwise.
+func (p *TxPool) recheckConditionals(head *types.Header) {
+	p.conditionalLock.RLock()
+	conditionals := maps.Clone(p.conditionals)
+	p.conditionalLock.RUnlock()
+
+	if len(conditionals) == 0 {
+		return
+	}
+	statedb, err := p.chain.StateAt(head.Root)
+	if err != nil {
+		log.Warn("Failed to recheck conditional transactions", "err", err)
+		return
+	}
+	var stale, failed []common.Hash
+	for hash, cond := range conditionals {
+		if !p.Has(hash) {
+			stale = append(stale, hash)
+			continue
+		}
+		if cond.Expired(head) {
+			failed = append(failed, hash)
+		} else if err := cond.CheckState(statedb); err != nil {
+			log.Debug("Transaction condition failed", "hash", hash, "err", err)
+			failed = append(failed, hash)
+		}
+	}
+	// Forget about the checked conditions, unless they were resubmitted meanwhile
+	p.conditionalLock.Lock()
+	for _, hash := range append(stale, failed...) {
+		if p.conditionals[hash] == conditionals[hash] {
+			delete(p.conditionals, hash)
+		}
+	}
+	p.conditionalLock.Unlock()
+
+	for _, hash := range failed {
+		p.drop(hash, DropConditionFailed)
+	}
+}
+
+// drop removes the transaction with the given hash from the subpool holding it.
+func (p *TxPool) drop(hash common.Hash, reason DropReason) bool {
+	for _, subpool := range p.subpools {
+		if subpool.Drop(hash, reason) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+func TestTxConditionalCheck(t *testing.T) {
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+
+	addr := common.Address{0x01}
+	statedb.SetBalance(addr, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	statedb.SetState(addr, common.Hash{0x01}, common.Hash{0x02})
+	statedb.IntermediateRoot(true)
+	root := statedb.GetStorageRoot(addr)
+
+	u64 := func(n uint64) *uint64 { return &n }
+	header := &types.Header{Number: big.NewInt(10), Time: 100}
+
+	tests := []struct {
+		cond    TxConditional
+		fail    bool
+		expired bool
+	}{
+		{cond: TxConditional{}},
+		{cond: TxConditional{BlockNumberMin: u64(10), BlockNumberMax: u64(10)}, expired: true},
+		{cond: TxConditional{BlockNumberMin: u64(11)}, fail: true},
+		{cond: TxConditional{BlockNumberMax: u64(9)}, fail: true, expired: true},
+		{cond: TxConditional{TimestampMin: u64(100), TimestampMax: u64(101)}},
+		{cond: TxConditional{TimestampMin: u64(101)}, fail: true},
+		{cond: TxConditional{TimestampMax: u64(99)}, fail: true, expired: true},
+		{cond: TxConditional{KnownAccounts: map[common.Address]KnownAccount{addr: {StorageRoot: &root}}}},
+		{cond: TxConditional{KnownAccounts: map[common.Address]KnownAccount{addr: {StorageRoot: &common.Hash{}}}}, fail: true},
+		{cond: TxConditional{KnownAccounts: map[common.Address]KnownAccount{addr: {StorageSlots: map[common.Hash]common.Hash{{0x01}: {0x02}}}}}},
+		{cond: TxConditional{KnownAccounts: map[common.Address]KnownAccount{addr: {StorageSlots: map[common.Hash]common.Hash{{0x01}: {0x03}}}}}, fail: true},
+	}
+	for i, tt := range tests {
+		err := tt.cond.Check(header, statedb)
+		if tt.fail != (err != nil) {
+			t.Errorf("test %d: check result mismatch: have %v, want failure %v", i, err, tt.fail)
+		}
+		if err != nil && !errors.Is(err, ErrConditionFailed) {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+		}
+		if expired := tt.cond.Expired(header); expired != tt.expired {
+			t.Errorf("test %d: expiry mismatch: have %v, want %v", i, expired, tt.expired)
+		}
+	}
+	// Conditions which can never hold are rejected
+	if err := (&TxConditional{BlockNumberMin: u64(2), BlockNumberMax: u64(1)}).Validate(); err == nil {
+		t.Error("empty block range accepted")
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+)
+
+// TxBlocker is a reason why a pooled transaction is not executable, or can't be
+// included into a block even though it is.
+type TxBlocker uint8
+
+const (
+	TxBlockerNonceGap          TxBlocker = iota // A preceding nonce of the sender is missing from the pool
+	TxBlockerInsufficientFunds                  // Balance can't cover the cumulative cost of the sender's transactions
+	TxBlockerUnderpriced                        // Tip is below the minimum accepted by the pool
+	TxBlockerAccountLimit                       // Sender exceeds the slots guaranteed per account, evicted first if the pool fills up
+)
+
+// String implements fmt.Stringer.
+func (b TxBlocker) String() string {
+	switch b {
+	case TxBlockerNonceGap:
+		return "nonceGap"
+	case TxBlockerInsufficientFunds:
+		return "insufficientFunds"
+	case TxBlockerUnderpriced:
+		return "underpriced"
+	case TxBlockerAccountLimit:
+		return "accountLimit"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(b))
+	}
+}
+
+// TxDiagnosis is the status of a pooled transaction along with the reasons
+// holding it back.
+type TxDiagnosis struct {
+	Hash     common.Hash
+	Nonce    uint64
+	Type     uint8
+	Status   TxStatus     // Pending or queued
+	Cost     *uint256.Int // Cumulative cost of the sender's transactions up to this one
+	Blockers []TxBlocker  // Reasons holding the transaction back, empty if none
+}
+
+// AccountDiagnosis explains the state of the pooled transactions of an account.
+type AccountDiagnosis struct {
+	Nonce   uint64         // Nonce of the account at the current head
+	Balance *uint256.Int   // Balance of the account at the current head
+	Txs     []*TxDiagnosis // Pooled transactions of the account, sorted by nonce
+
+	// RejectedTypes are the transaction types the pool currently rejects from
+	// the account, as it is reserved by the subpool holding its transactions.
+	// A pending blob transaction blocks all other types and vice versa.
+	RejectedTypes []uint8
+}
@@ -72,6 +72,14 @@ var (
 	// transactions is reached for specific accounts.
 	ErrInflightTxLimitReached = errors.New("in-flight transaction limit reached for delegated accounts")
 
+	// ErrPrivateTxExpired is returned if a private transaction is submitted with
+	// an inclusion deadline which has already passed.
+	ErrPrivateTxExpired = errors.New("private transaction already expired")
+
+	// ErrConditionFailed is returned if the condition of a conditional transaction
+	// doesn't hold.
+	ErrConditionFailed = errors.New("transaction condition failed")
+
 	// ErrKZGVerificationError is returned when a KZG proof was not verified correctly.
 	ErrKZGVerificationError = errors.New("KZG verification error")
 )
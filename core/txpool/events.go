@@ -0,0 +1,226 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// txEventQueueSize is the number of event batches queued for a subscriber of a
+// TxEventFeed before it is considered too slow and disconnected.
+const txEventQueueSize = 1024
+
+// ErrTxEventsTooSlow is reported on the error channel of a lifecycle event
+// subscription that fell too far behind the pool and was disconnected.
+var ErrTxEventsTooSlow = errors.New("transaction event subscriber too slow")
+
+// TxEventType is the kind of a transaction lifecycle event.
+type TxEventType uint8
+
+const (
+	TxEventAdded    TxEventType = iota // Transaction accepted into the pool
+	TxEventPromoted                    // Transaction became executable
+	TxEventReplaced                    // Transaction replaced by one with the same nonce
+	TxEventDropped                     // Transaction removed from the pool, see DropReason
+	TxEventIncluded                    // Transaction included in a block
+)
+
+// String implements fmt.Stringer.
+func (t TxEventType) String() string {
+	switch t {
+	case TxEventAdded:
+		return "added"
+	case TxEventPromoted:
+		return "promoted"
+	case TxEventReplaced:
+		return "replaced"
+	case TxEventDropped:
+		return "dropped"
+	case TxEventIncluded:
+		return "included"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// DropReason is the reason why a transaction was dropped from the pool.
+type DropReason uint8
+
+const (
+	DropUnderpriced       DropReason = iota // Priced out by better paying transactions or the minimum tip
+	DropAccountLimit                        // Sender exceeded the number of slots allowed per account
+	DropPoolFull                            // Evicted as the pool exceeded its global capacity
+	DropNonceTooLow                         // Nonce consumed by another transaction on chain
+	DropNonceGap                            // Preceding transaction of the sender was dropped
+	DropInsufficientFunds                   // Sender can't cover the cost of the transaction anymore
+	DropGasLimit                            // Transaction exceeds the block or transaction gas limit
+	DropExpired                             // Non-executable transaction outlived the pool lifetime
+	DropConditionFailed                     // Condition of a conditional transaction can't hold anymore
+)
+
+// String implements fmt.Stringer.
+func (r DropReason) String() string {
+	switch r {
+	case DropUnderpriced:
+		return "underpriced"
+	case DropAccountLimit:
+		return "accountLimit"
+	case DropPoolFull:
+		return "poolFull"
+	case DropNonceTooLow:
+		return "nonceTooLow"
+	case DropNonceGap:
+		return "nonceGap"
+	case DropInsufficientFunds:
+		return "insufficientFunds"
+	case DropGasLimit:
+		return "gasLimit"
+	case DropExpired:
+		return "expired"
+	case DropConditionFailed:
+		return "conditionFailed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
+
+// TxEvent is a transaction lifecycle event emitted by the pools. Events of a
+// single sender are delivered in the order they happened.
+type TxEvent struct {
+	Type  TxEventType
+	Hash  common.Hash    // Hash of the transaction
+	From  common.Address // Sender of the transaction
+	Nonce uint64         // Nonce of the transaction
+
+	Reason      DropReason  // Reason of the removal, set for TxEventDropped
+	ReplacedBy  common.Hash // Hash of the replacement, set for TxEventReplaced
+	BlockHash   common.Hash // Hash of the including block, set for TxEventIncluded
+	BlockNumber uint64      // Number of the including block, set for TxEventIncluded
+}
+
+// NewTxEvent creates a lifecycle event of the given type for a transaction whose
+// sender is already known.
+func NewTxEvent(typ TxEventType, tx *types.Transaction, from common.Address) TxEvent {
+	return TxEvent{
+		Type:  typ,
+		Hash:  tx.Hash(),
+		From:  from,
+		Nonce: tx.Nonce(),
+	}
+}
+
+// TxEventFeed delivers batches of lifecycle events to subscribers without ever
+// blocking the sender, so that pools can emit events while holding their lock.
+// Every subscriber has a bounded queue drained by a dedicated goroutine. If the
+// queue of a subscriber overflows, it is disconnected with ErrTxEventsTooSlow.
+//
+// The zero value is ready to use.
+type TxEventFeed struct {
+	lock sync.Mutex
+	subs map[*txEventSub]struct{}
+}
+
+// Subscribe adds a channel to the feed.
+func (f *TxEventFeed) Subscribe(ch chan<- []TxEvent) event.Subscription {
+	sub := &txEventSub{
+		feed:  f,
+		ch:    ch,
+		queue: make(chan []TxEvent, txEventQueueSize),
+		quit:  make(chan struct{}),
+		err:   make(chan error, 1),
+	}
+	f.lock.Lock()
+	if f.subs == nil {
+		f.subs = make(map[*txEventSub]struct{})
+	}
+	f.subs[sub] = struct{}{}
+	f.lock.Unlock()
+
+	go sub.loop()
+	return sub
+}
+
+// Send queues a batch of events for delivery to all subscribers. It never blocks.
+func (f *TxEventFeed) Send(events []TxEvent) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for sub := range f.subs {
+		select {
+		case sub.queue <- events:
+		default:
+			delete(f.subs, sub)
+			sub.close(ErrTxEventsTooSlow)
+		}
+	}
+}
+
+// txEventSub is a subscription of a TxEventFeed.
+type txEventSub struct {
+	feed  *TxEventFeed
+	ch    chan<- []TxEvent
+	queue chan []TxEvent
+	quit  chan struct{}
+	err   chan error
+	once  sync.Once
+}
+
+// loop forwards the queued events to the subscriber's channel.
+func (sub *txEventSub) loop() {
+	for {
+		select {
+		case events := <-sub.queue:
+			select {
+			case sub.ch <- events:
+			case <-sub.quit:
+				return
+			}
+		case <-sub.quit:
+			return
+		}
+	}
+}
+
+// close terminates the subscription, reporting err if it is non-nil.
+func (sub *txEventSub) close(err error) {
+	sub.once.Do(func() {
+		close(sub.quit)
+		if err != nil {
+			sub.err <- err
+		}
+		close(sub.err)
+	})
+}
+
+// Unsubscribe implements event.Subscription.
+func (sub *txEventSub) Unsubscribe() {
+	sub.feed.lock.Lock()
+	delete(sub.feed.subs, sub)
+	sub.feed.lock.Unlock()
+	sub.close(nil)
+}
+
+// Err implements event.Subscription.
+func (sub *txEventSub) Err() <-chan error {
+	return sub.err
+}
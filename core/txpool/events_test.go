@@ -0,0 +1,61 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"testing"
+	"time"
+)
+
+// Tests that the lifecycle event feed delivers events in order without blocking
+// the sender, and disconnects subscribers which fall too far behind.
+func TestTxEventFeed(t *testing.T) {
+	var (
+		feed TxEventFeed
+		fast = make(chan []TxEvent)
+		slow = make(chan []TxEvent)
+	)
+	fastSub := feed.Subscribe(fast)
+	defer fastSub.Unsubscribe()
+	slowSub := feed.Subscribe(slow)
+	defer slowSub.Unsubscribe()
+
+	for i := 0; i < 2*txEventQueueSize; i++ {
+		feed.Send([]TxEvent{{Nonce: uint64(i)}})
+		select {
+		case events := <-fast:
+			if events[0].Nonce != uint64(i) {
+				t.Fatalf("event %d: wrong nonce %d", i, events[0].Nonce)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %d not delivered", i)
+		}
+	}
+	select {
+	case err := <-slowSub.Err():
+		if err != ErrTxEventsTooSlow {
+			t.Fatalf("wrong error for slow subscriber: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow subscriber not disconnected")
+	}
+	select {
+	case err := <-fastSub.Err():
+		t.Fatalf("fast subscriber disconnected: %v", err)
+	default:
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// Diagnose reports the status of the pooled transactions of an account along
+// with the reasons holding them back.
+func (pool *LegacyPool) Diagnose(addr common.Address) []*txpool.TxDiagnosis {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	var txs types.Transactions
+	if list := pool.pending[addr]; list != nil {
+		txs = list.Flatten()
+	}
+	pending := len(txs)
+	if list, ok := pool.queue.get(addr); ok {
+		txs = append(txs, list.Flatten()...)
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+	var (
+		balance = pool.currentState.GetBalance(addr)
+		next    = pool.currentState.GetNonce(addr)
+		gasTip  = pool.gasTip.Load().ToBig()
+		cost    = new(uint256.Int)
+		gapped  bool
+		diags   = make([]*txpool.TxDiagnosis, 0, len(txs))
+	)
+	for i, tx := range txs {
+		// The balance has to cover the transactions executed before as well,
+		// the same cumulative cost the list tracks for the whole account.
+		cost.Add(cost, uint256.MustFromBig(tx.Cost()))
+
+		diag := &txpool.TxDiagnosis{
+			Hash:   tx.Hash(),
+			Nonce:  tx.Nonce(),
+			Type:   tx.Type(),
+			Status: txpool.TxStatusPending,
+			Cost:   cost.Clone(),
+		}
+		if i < pending {
+			if uint64(i) >= pool.config.AccountSlots {
+				diag.Blockers = append(diag.Blockers, txpool.TxBlockerAccountLimit)
+			}
+		} else {
+			diag.Status = txpool.TxStatusQueued
+			if tx.Nonce() > next {
+				gapped = true
+			}
+			if gapped {
+				diag.Blockers = append(diag.Blockers, txpool.TxBlockerNonceGap)
+			}
+			if uint64(i-pending) >= pool.config.AccountQueue {
+				diag.Blockers = append(diag.Blockers, txpool.TxBlockerAccountLimit)
+			}
+		}
+		if cost.Gt(balance) {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerInsufficientFunds)
+		}
+		if tx.GasTipCapIntCmp(gasTip) < 0 {
+			diag.Blockers = append(diag.Blockers, txpool.TxBlockerUnderpriced)
+		}
+		diags = append(diags, diag)
+		next = tx.Nonce() + 1
+	}
+	return diags
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// Tests that the reasons holding back the transactions of an account are
+// reported correctly.
+func TestDiagnose(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	txs := []*types.Transaction{
+		pricedTransaction(0, 100000, big.NewInt(2), key),
+		pricedTransaction(1, 100000, big.NewInt(2), key),
+		pricedTransaction(2, 100000, big.NewInt(2), key),
+		pricedTransaction(4, 100000, big.NewInt(1), key),
+	}
+	for i, tx := range txs {
+		if err := pool.addRemoteSync(tx); err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+	if diags := pool.Diagnose(common.Address{0x01}); diags != nil {
+		t.Fatalf("diagnosis for unknown account: %v", diags)
+	}
+	// Drain the balance below the cumulative cost of the third transaction and
+	// raise the minimum tip above the price of the queued one.
+	pool.mu.Lock()
+	pool.currentState.SetBalance(from, uint256.NewInt(450000), tracing.BalanceChangeUnspecified)
+	pool.mu.Unlock()
+	pool.gasTip.Store(uint256.NewInt(2))
+
+	want := [][]txpool.TxBlocker{
+		nil,
+		nil,
+		{txpool.TxBlockerInsufficientFunds},
+		{txpool.TxBlockerNonceGap, txpool.TxBlockerInsufficientFunds, txpool.TxBlockerUnderpriced},
+	}
+	diags := pool.Diagnose(from)
+	if len(diags) != len(txs) {
+		t.Fatalf("diagnosis count mismatch: have %d, want %d", len(diags), len(txs))
+	}
+	var cost uint64
+	for i, diag := range diags {
+		cost += txs[i].Cost().Uint64()
+		if diag.Hash != txs[i].Hash() || diag.Nonce != txs[i].Nonce() {
+			t.Errorf("tx %d: transaction mismatch", i)
+		}
+		status := txpool.TxStatusPending
+		if i == 3 {
+			status = txpool.TxStatusQueued
+		}
+		if diag.Status != status {
+			t.Errorf("tx %d: status mismatch: have %v, want %v", i, diag.Status, status)
+		}
+		if diag.Cost.Uint64() != cost {
+			t.Errorf("tx %d: cumulative cost mismatch: have %v, want %v", i, diag.Cost, cost)
+		}
+		if !reflect.DeepEqual(diag.Blockers, want[i]) {
+			t.Errorf("tx %d: blockers mismatch: have %v, want %v", i, diag.Blockers, want[i])
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// droppedTx is a transaction removed from the pool along with the reason.
+type droppedTx struct {
+	tx     *types.Transaction
+	reason txpool.DropReason
+}
+
+// inclusion is the block a transaction was included in.
+type inclusion struct {
+	hash   common.Hash
+	number uint64
+}
+
+// SubscribeTxEvents registers a subscription for transaction lifecycle events.
+func (pool *LegacyPool) SubscribeTxEvents(ch chan<- []txpool.TxEvent) event.Subscription {
+	return pool.txEventFeed.Subscribe(ch)
+}
+
+// emitTxEvent queues a lifecycle event of a transaction, to be delivered once
+// the pool lock is released. The returned event may be amended by the caller
+// until the next event is queued.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) emitTxEvent(typ txpool.TxEventType, tx *types.Transaction) *txpool.TxEvent {
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	pool.txEvents = append(pool.txEvents, txpool.NewTxEvent(typ, tx, from))
+	return &pool.txEvents[len(pool.txEvents)-1]
+}
+
+// emitReplaced queues the event of a transaction replaced by another one.
+func (pool *LegacyPool) emitReplaced(old *types.Transaction, tx *types.Transaction) {
+	pool.emitTxEvent(txpool.TxEventReplaced, old).ReplacedBy = tx.Hash()
+}
+
+// emitDropped queues the event of a transaction dropped from the pool.
+func (pool *LegacyPool) emitDropped(tx *types.Transaction, reason txpool.DropReason) {
+	pool.emitTxEvent(txpool.TxEventDropped, tx).Reason = reason
+}
+
+// emitStale queues the event of a transaction whose nonce was consumed on chain,
+// either by the transaction itself or by a different one.
+func (pool *LegacyPool) emitStale(tx *types.Transaction) {
+	if block, ok := pool.included[tx.Hash()]; ok {
+		ev := pool.emitTxEvent(txpool.TxEventIncluded, tx)
+		ev.BlockHash, ev.BlockNumber = block.hash, block.number
+		return
+	}
+	pool.emitDropped(tx, txpool.DropNonceTooLow)
+}
+
+// trackIncluded records the transactions of a newly imported block, allowing the
+// next reorg run to tell included transactions from replaced ones.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) trackIncluded(block *types.Block) {
+	if pool.included == nil {
+		pool.included = make(map[common.Hash]inclusion)
+	}
+	ref := inclusion{hash: block.Hash(), number: block.NumberU64()}
+	for _, tx := range block.Transactions() {
+		pool.included[tx.Hash()] = ref
+	}
+}
+
+// unlockAndSendTxEvents hands the queued lifecycle events over for delivery and
+// releases the pool lock. The feed never blocks, so the events are handed over
+// while still holding the lock, preserving their order across pool operations.
+func (pool *LegacyPool) unlockAndSendTxEvents() {
+	if len(pool.txEvents) > 0 {
+		pool.txEventFeed.Send(pool.txEvents)
+		pool.txEvents = nil
+	}
+	pool.mu.Unlock()
+}
+
+// unpayableReason returns the reason why a transaction filtered out for its cost
+// or gas was dropped.
+func unpayableReason(tx *types.Transaction, gasLimit uint64) txpool.DropReason {
+	if tx.Gas() > gasLimit {
+		return txpool.DropGasLimit
+	}
+	return txpool.DropInsufficientFunds
+}
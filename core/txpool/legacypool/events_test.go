@@ -0,0 +1,167 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+// checkTxEvents waits for the given lifecycle events and ensures no more are
+// fired.
+func checkTxEvents(t *testing.T, events chan []txpool.TxEvent, want []txpool.TxEvent) {
+	t.Helper()
+
+	var received []txpool.TxEvent
+	for len(received) < len(want) {
+		select {
+		case evs := <-events:
+			received = append(received, evs...)
+		case <-time.After(time.Second):
+			t.Fatalf("event #%d not fired, want %v", len(received), want[len(received)])
+		}
+	}
+	select {
+	case evs := <-events:
+		received = append(received, evs...)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if len(received) != len(want) {
+		t.Fatalf("event count mismatch: have %d, want %d: %v", len(received), len(want), received)
+	}
+	for i := range want {
+		if received[i] != want[i] {
+			t.Errorf("event %d mismatch: have %+v, want %+v", i, received[i], want[i])
+		}
+	}
+}
+
+// Tests that the lifecycle of transactions is reported through the event feed.
+func TestTxEvents(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	events := make(chan []txpool.TxEvent, 16)
+	sub := pool.SubscribeTxEvents(events)
+	defer sub.Unsubscribe()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	event := func(typ txpool.TxEventType, tx *types.Transaction) txpool.TxEvent {
+		return txpool.NewTxEvent(typ, tx, from)
+	}
+	// Add a transaction to the queue first and promote it by filling the gap
+	tx0 := pricedTransaction(0, 100000, big.NewInt(1), key)
+	tx1 := pricedTransaction(1, 100000, big.NewInt(1), key)
+	if err := pool.addRemoteSync(tx1); err != nil {
+		t.Fatalf("failed to add queued transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(tx0); err != nil {
+		t.Fatalf("failed to add pending transaction: %v", err)
+	}
+	checkTxEvents(t, events, []txpool.TxEvent{
+		event(txpool.TxEventAdded, tx1),
+		event(txpool.TxEventAdded, tx0),
+		event(txpool.TxEventPromoted, tx0),
+		event(txpool.TxEventPromoted, tx1),
+	})
+
+	// Replace the pending transaction with a better paying one
+	tx0b := pricedTransaction(0, 100000, big.NewInt(2), key)
+	if err := pool.addRemoteSync(tx0b); err != nil {
+		t.Fatalf("failed to replace pending transaction: %v", err)
+	}
+	replaced := event(txpool.TxEventReplaced, tx0)
+	replaced.ReplacedBy = tx0b.Hash()
+	checkTxEvents(t, events, []txpool.TxEvent{replaced, event(txpool.TxEventAdded, tx0b)})
+
+	// Include the replacement in a block, the follow-up transaction gets dropped
+	// once the account can't pay for it anymore
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1)}, &types.Body{Transactions: types.Transactions{tx0b}}, nil, trie.NewStackTrie(nil))
+
+	pool.mu.Lock()
+	pool.trackIncluded(block)
+	pool.currentState.SetNonce(from, 1, tracing.NonceChangeUnspecified)
+	pool.currentState.SetBalance(from, new(uint256.Int), tracing.BalanceChangeUnspecified)
+	pool.mu.Unlock()
+	<-pool.requestReset(nil, nil)
+
+	included := event(txpool.TxEventIncluded, tx0b)
+	included.BlockHash, included.BlockNumber = block.Hash(), 1
+	dropped := event(txpool.TxEventDropped, tx1)
+	dropped.Reason = txpool.DropInsufficientFunds
+	checkTxEvents(t, events, []txpool.TxEvent{included, dropped})
+
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that explicitly dropping a transaction removes it along with demoting
+// its dependents, reporting the drop with the requested reason.
+func TestDropTransaction(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	tx0 := pricedTransaction(0, 100000, big.NewInt(1), key)
+	tx1 := pricedTransaction(1, 100000, big.NewInt(1), key)
+	for _, err := range pool.Add([]*types.Transaction{tx0, tx1}, true) {
+		if err != nil {
+			t.Fatalf("failed to add transaction: %v", err)
+		}
+	}
+	events := make(chan []txpool.TxEvent, 16)
+	sub := pool.SubscribeTxEvents(events)
+	defer sub.Unsubscribe()
+
+	if pool.Drop(common.Hash{0x01}, txpool.DropExpired) {
+		t.Fatal("dropped unknown transaction")
+	}
+	if !pool.Drop(tx0.Hash(), txpool.DropExpired) {
+		t.Fatal("failed to drop pooled transaction")
+	}
+	dropped := txpool.NewTxEvent(txpool.TxEventDropped, tx0, from)
+	dropped.Reason = txpool.DropExpired
+	checkTxEvents(t, events, []txpool.TxEvent{dropped})
+
+	if pool.Has(tx0.Hash()) {
+		t.Error("dropped transaction still pooled")
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 1 {
+		t.Errorf("pool stats mismatch: have %d/%d, want 0/1", pending, queued)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
@@ -143,6 +143,9 @@ type Config struct {
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
+	Snapshot         string        // Snapshot of the entire pool to survive node restarts (empty disables)
+	SnapshotInterval time.Duration // Time interval to regenerate the pool snapshot
+
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
 
@@ -159,6 +162,8 @@ var DefaultConfig = Config{
 	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
 
+	SnapshotInterval: 10 * time.Minute,
+
 	PriceLimit: 1,
 	PriceBump:  10,
 
@@ -202,6 +207,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultConfig.Lifetime)
 		conf.Lifetime = DefaultConfig.Lifetime
 	}
+	if conf.Snapshot != "" && conf.SnapshotInterval < time.Second {
+		log.Warn("Sanitizing invalid txpool snapshot interval", "provided", conf.SnapshotInterval, "updated", DefaultConfig.SnapshotInterval)
+		conf.SnapshotInterval = DefaultConfig.SnapshotInterval
+	}
 	return conf
 }
 
@@ -254,6 +263,12 @@ type LegacyPool struct {
 	initDoneCh      chan struct{}  // is closed once the pool is initialized (for tests)
 
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
+
+	txEventFeed txpool.TxEventFeed        // Feed of transaction lifecycle events
+	txEvents    []txpool.TxEvent          // Lifecycle events waiting for the pool lock to be released
+	included    map[common.Hash]inclusion // Transactions included since the last reorg run
+
+	snapshotExclude func(hash common.Hash) bool // Transactions to leave out of the pool snapshot
 }
 
 type txpoolResetRequest struct {
@@ -333,6 +348,13 @@ func (pool *LegacyPool) Init(gasTip uint64, head *types.Header, reserver txpool.
 
 	pool.wg.Add(1)
 	go pool.loop()
+
+	// Restore the pool contents from before the last shutdown, if enabled
+	if pool.config.Snapshot != "" {
+		if err := pool.loadSnapshot(); err != nil {
+			log.Warn("Failed to load transaction pool snapshot", "err", err)
+		}
+	}
 	return nil
 }
 
@@ -348,10 +370,19 @@ func (pool *LegacyPool) loop() {
 		// Start the stats reporting and transaction eviction tickers
 		report = time.NewTicker(statsReportInterval)
 		evict  = time.NewTicker(evictionInterval)
+
+		// Start the pool snapshot ticker, if enabled
+		snapshot <-chan time.Time
 	)
 	defer report.Stop()
 	defer evict.Stop()
 
+	if pool.config.Snapshot != "" {
+		ticker := time.NewTicker(pool.config.SnapshotInterval)
+		defer ticker.Stop()
+		snapshot = ticker.C
+	}
+
 	// Notify tests that the init phase is done
 	close(pool.initDoneCh)
 	for {
@@ -376,9 +407,18 @@ func (pool *LegacyPool) loop() {
 		case <-evict.C:
 			pool.mu.Lock()
 			for _, hash := range pool.queue.evictList() {
+				if tx := pool.all.Get(hash); tx != nil {
+					pool.emitDropped(tx, txpool.DropExpired)
+				}
 				pool.removeTx(hash, true, true)
 			}
-			pool.mu.Unlock()
+			pool.unlockAndSendTxEvents()
+
+		// Handle periodic pool snapshots
+		case <-snapshot:
+			if err := pool.writeSnapshot(); err != nil {
+				log.Warn("Failed to write transaction pool snapshot", "err", err)
+			}
 		}
 	}
 }
@@ -389,6 +429,13 @@ func (pool *LegacyPool) Close() error {
 	close(pool.reorgShutdownCh)
 	pool.wg.Wait()
 
+	// Persist the pool contents to restore them on the next startup
+	if pool.config.Snapshot != "" {
+		if err := pool.writeSnapshot(); err != nil {
+			log.Warn("Failed to write transaction pool snapshot", "err", err)
+		}
+	}
+
 	log.Info("Transaction pool stopped")
 	return nil
 }
@@ -400,6 +447,21 @@ func (pool *LegacyPool) Reset(oldHead, newHead *types.Header) {
 	<-wait
 }
 
+// Drop implements txpool.SubPool, removing a transaction from the pool. The
+// subsequent transactions of the same sender are moved back to the queue.
+func (pool *LegacyPool) Drop(hash common.Hash, reason txpool.DropReason) bool {
+	pool.mu.Lock()
+	defer pool.unlockAndSendTxEvents()
+
+	tx := pool.all.Get(hash)
+	if tx == nil {
+		return false
+	}
+	pool.emitDropped(tx, reason)
+	pool.removeTx(hash, true, true)
+	return true
+}
+
 // SubscribeTransactions registers a subscription for new transaction events,
 // supporting feeding only newly seen or also resurrected transactions.
 func (pool *LegacyPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription {
@@ -414,7 +476,7 @@ func (pool *LegacyPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs
 // new transaction, and drops all transactions below this threshold.
 func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 	pool.mu.Lock()
-	defer pool.mu.Unlock()
+	defer pool.unlockAndSendTxEvents()
 
 	var (
 		newTip = uint256.MustFromBig(tip)
@@ -426,6 +488,7 @@ func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 		// pool.priced is sorted by GasFeeCap, so we have to iterate through pool.all instead
 		drop := pool.all.TxsBelowTip(tip)
 		for _, tx := range drop {
+			pool.emitDropped(tx, txpool.DropUnderpriced)
 			pool.removeTx(tx.Hash(), false, true)
 		}
 		pool.priced.Removed(len(drop))
@@ -753,6 +816,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 			underpricedTxMeter.Mark(1)
 
 			sender, _ := types.Sender(pool.signer, tx)
+			pool.emitDropped(tx, txpool.DropUnderpriced)
 			dropped := pool.removeTx(tx.Hash(), false, sender != from) // Don't unreserve the sender of the tx being added if last from the acc
 
 			pool.changesSinceReorg += dropped
@@ -772,10 +836,12 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.emitReplaced(old, tx)
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
 		pool.queueTxEvent(tx)
+		pool.emitTxEvent(txpool.TxEventAdded, tx)
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 
 		// Successful replacement. If needed, bump the heartbeat giving more time to queued txs.
@@ -787,6 +853,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 	if err != nil {
 		return false, err
 	}
+	pool.emitTxEvent(txpool.TxEventAdded, tx)
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -825,6 +892,9 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 		return false, err
 	}
 	if replaced != nil {
+		if old := pool.all.Get(*replaced); old != nil {
+			pool.emitReplaced(old, tx)
+		}
 		pool.removeTx(*replaced, true, true)
 	}
 	// If the transaction isn't in lookup set but it's expected to be there,
@@ -857,6 +927,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
 		pendingDiscardMeter.Mark(1)
+		pool.emitDropped(tx, txpool.DropUnderpriced)
 		return false
 	}
 	// Otherwise discard any previous transaction and mark this
@@ -864,6 +935,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.emitReplaced(old, tx)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -936,7 +1008,7 @@ func (pool *LegacyPool) Add(txs []*types.Transaction, sync bool) []error {
 	// Process all the new transaction and merge any errors into the original slice
 	pool.mu.Lock()
 	dirtyAddrs := pool.addTxsLocked(txs, errs)
-	pool.mu.Unlock()
+	pool.unlockAndSendTxEvents()
 
 	// Reorg the pool internals if needed and return
 	done := pool.requestPromoteExecutables(dirtyAddrs)
@@ -1231,6 +1303,9 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 					return true
 				})
 				for _, hash := range hashes {
+					if tx := pool.all.Get(hash); tx != nil {
+						pool.emitDropped(tx, txpool.DropGasLimit)
+					}
 					pool.removeTx(hash, true, true)
 				}
 			}
@@ -1278,7 +1353,8 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 
 	dropBetweenReorgHistogram.Update(int64(pool.changesSinceReorg))
 	pool.changesSinceReorg = 0 // Reset change counter
-	pool.mu.Unlock()
+	pool.included = nil
+	pool.unlockAndSendTxEvents()
 
 	// Notify subsystems for newly added transactions
 	for _, tx := range promoted {
@@ -1350,6 +1426,7 @@ func (pool *LegacyPool) reset(oldHead, newHead *types.Header) {
 				}
 				for add.NumberU64() > rem.NumberU64() {
 					included = append(included, add.Transactions()...)
+					pool.trackIncluded(add)
 					if add = pool.chain.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
 						log.Error("Unrooted new chain seen by tx pool", "block", newHead.Number, "hash", newHead.Hash())
 						return
@@ -1362,6 +1439,7 @@ func (pool *LegacyPool) reset(oldHead, newHead *types.Header) {
 						return
 					}
 					included = append(included, add.Transactions()...)
+					pool.trackIncluded(add)
 					if add = pool.chain.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
 						log.Error("Unrooted new chain seen by tx pool", "block", newHead.Number, "hash", newHead.Hash())
 						return
@@ -1376,6 +1454,12 @@ func (pool *LegacyPool) reset(oldHead, newHead *types.Header) {
 				reinject = lost
 			}
 		}
+	} else if oldHead != nil && newHead != nil {
+		// Track the transactions included by the new head to tell them apart
+		// from the ones replaced on chain
+		if block := pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64()); block != nil {
+			pool.trackIncluded(block)
+		}
 	}
 	// Initialize the internal state to the current head
 	if newHead == nil {
@@ -1409,12 +1493,18 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 		from, _ := pool.signer.Sender(tx)
 		if pool.promoteTx(from, tx.Hash(), tx) {
 			promoted = append(promoted, tx)
+			pool.emitTxEvent(txpool.TxEventPromoted, tx)
 		}
 	}
 
 	// remove all removable transactions
-	for _, hash := range dropped {
-		pool.all.Remove(hash)
+	for _, drop := range dropped {
+		pool.all.Remove(drop.tx.Hash())
+		if drop.reason == txpool.DropNonceTooLow {
+			pool.emitStale(drop.tx)
+		} else {
+			pool.emitDropped(drop.tx, drop.reason)
+		}
 	}
 	pool.priced.Removed(len(dropped))
 
@@ -1471,6 +1561,7 @@ func (pool *LegacyPool) truncatePending() {
 						// Drop the transaction from the global pools too
 						hash := tx.Hash()
 						pool.all.Remove(hash)
+						pool.emitDropped(tx, txpool.DropAccountLimit)
 
 						// Update the account nonce to the dropped transaction
 						pool.pendingNonces.setIfLower(offenders[i], tx.Nonce())
@@ -1496,6 +1587,7 @@ func (pool *LegacyPool) truncatePending() {
 					// Drop the transaction from the global pools too
 					hash := tx.Hash()
 					pool.all.Remove(hash)
+					pool.emitDropped(tx, txpool.DropAccountLimit)
 
 					// Update the account nonce to the dropped transaction
 					pool.pendingNonces.setIfLower(addr, tx.Nonce())
@@ -1516,6 +1608,9 @@ func (pool *LegacyPool) truncateQueue() {
 
 	// Remove all removable transactions from the lookup and global price list
 	for _, hash := range removed {
+		if tx := pool.all.Get(hash); tx != nil {
+			pool.emitDropped(tx, txpool.DropPoolFull)
+		}
 		pool.all.Remove(hash)
 	}
 	pool.priced.Removed(len(removed))
@@ -1546,6 +1641,7 @@ func (pool *LegacyPool) demoteUnexecutables() {
 		for _, tx := range olds {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
+			pool.emitStale(tx)
 			log.Trace("Removed old pending transaction", "hash", hash)
 		}
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
@@ -1553,6 +1649,7 @@ func (pool *LegacyPool) demoteUnexecutables() {
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
+			pool.emitDropped(tx, unpayableReason(tx, gasLimit))
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 		}
 		pendingNofundsMeter.Mark(int64(len(drops)))
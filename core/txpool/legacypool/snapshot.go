@@ -0,0 +1,174 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// snapshotVersion is the current version of the pool snapshot format.
+const snapshotVersion = 1
+
+// snapshotBatchSize is the number of snapshotted transactions injected into the
+// pool at once during startup.
+const snapshotBatchSize = 1024
+
+// snapshotEntry is a transaction in the pool snapshot along with the time it
+// first arrived in the pool.
+type snapshotEntry struct {
+	Time uint64 // Arrival time in unix nanoseconds
+	Tx   *types.Transaction
+}
+
+// SetSnapshotFilter implements txpool.Snapshotter, setting the callback telling
+// which transactions to leave out of the pool snapshot.
+func (pool *LegacyPool) SetSnapshotFilter(exclude func(hash common.Hash) bool) {
+	pool.snapshotExclude = exclude
+}
+
+// writeSnapshot dumps the pending and queued transactions of the pool into the
+// snapshot file, atomically replacing any previous snapshot. Transactions rejected
+// by the snapshot filter are skipped.
+func (pool *LegacyPool) writeSnapshot() error {
+	var (
+		start           = time.Now()
+		pending, queued = pool.Content()
+		path            = pool.config.Snapshot
+	)
+	if pool.snapshotExclude != nil {
+		for _, set := range []map[common.Address][]*types.Transaction{pending, queued} {
+			for addr, txs := range set {
+				set[addr] = slices.DeleteFunc(txs, func(tx *types.Transaction) bool {
+					return pool.snapshotExclude(tx.Hash())
+				})
+			}
+		}
+	}
+	output, err := os.OpenFile(path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	count, err := encodeSnapshot(output, pending, queued)
+	if cerr := output.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path + ".new")
+		return err
+	}
+	if err := os.Rename(path+".new", path); err != nil {
+		return err
+	}
+	log.Info("Wrote transaction pool snapshot", "transactions", count, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// encodeSnapshot writes the given transaction sets into a snapshot stream,
+// returning the number of transactions written.
+func encodeSnapshot(w io.Writer, sets ...map[common.Address][]*types.Transaction) (int, error) {
+	if err := rlp.Encode(w, uint64(snapshotVersion)); err != nil {
+		return 0, err
+	}
+	var count int
+	for _, set := range sets {
+		for _, txs := range set {
+			for _, tx := range txs {
+				entry := &snapshotEntry{Time: uint64(tx.Time().UnixNano()), Tx: tx}
+				if err := rlp.Encode(w, entry); err != nil {
+					return count, err
+				}
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// loadSnapshot injects the transactions of a previous pool snapshot, restoring
+// their original arrival times. Every transaction is validated against the
+// current head as if it arrived from the network, transactions which outlived
+// the configured pool lifetime are skipped.
+func (pool *LegacyPool) loadSnapshot() error {
+	input, err := os.Open(pool.config.Snapshot)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	var (
+		stream = rlp.NewStream(input, 0)
+		cutoff = time.Now().Add(-pool.config.Lifetime)
+
+		total, stale, dropped int
+		batch                 []*types.Transaction
+	)
+	flush := func() {
+		for _, err := range pool.Add(batch, true) {
+			if err != nil {
+				log.Trace("Failed to add snapshotted transaction", "err", err)
+				dropped++
+			}
+		}
+		batch = batch[:0]
+	}
+	version, err := stream.Uint64()
+	if err != nil {
+		return err
+	}
+	if version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", version)
+	}
+	for {
+		var entry snapshotEntry
+		if err = stream.Decode(&entry); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		total++
+
+		arrived := time.Unix(0, int64(entry.Time))
+		if arrived.Before(cutoff) {
+			stale++
+			continue
+		}
+		entry.Tx.SetTime(arrived)
+		if batch = append(batch, entry.Tx); len(batch) >= snapshotBatchSize {
+			flush()
+		}
+	}
+	if len(batch) > 0 {
+		flush()
+	}
+	log.Info("Loaded transaction pool snapshot", "transactions", total, "stale", stale, "dropped", dropped)
+	return err
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package legacypool
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// Tests that the pool contents survive a restart if snapshotting is enabled,
+// retaining the arrival times and dropping anything invalidated meanwhile.
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		statedb, _ = state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+		blockchain = newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+		key1, _ = crypto.GenerateKey()
+		key2, _ = crypto.GenerateKey()
+		addr1   = crypto.PubkeyToAddress(key1.PublicKey)
+		addr2   = crypto.PubkeyToAddress(key2.PublicKey)
+
+		config = testTxPoolConfig
+	)
+	config.Snapshot = filepath.Join(t.TempDir(), "txpool.rlp")
+
+	statedb.AddBalance(addr1, uint256.NewInt(1000000000), tracing.BalanceChangeUnspecified)
+	statedb.AddBalance(addr2, uint256.NewInt(1000000000), tracing.BalanceChangeUnspecified)
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+	var (
+		arrival = time.Now().Add(-time.Minute).Round(0)
+		pending = []*types.Transaction{
+			pricedTransaction(0, 100000, big.NewInt(1), key1),
+			pricedTransaction(1, 100000, big.NewInt(1), key1),
+			pricedTransaction(0, 100000, big.NewInt(1), key2),
+		}
+		queued = pricedTransaction(5, 100000, big.NewInt(1), key1)
+		stale  = pricedTransaction(3, 100000, big.NewInt(1), key2)
+	)
+	for _, tx := range append(pending, queued) {
+		tx.SetTime(arrival)
+	}
+	stale.SetTime(arrival.Add(-config.Lifetime))
+
+	for _, err := range pool.Add(append(pending, queued, stale), true) {
+		if err != nil {
+			t.Fatalf("failed to add transaction: %v", err)
+		}
+	}
+	pool.Close()
+
+	// Include the first transaction of the second account meanwhile and restart
+	statedb.SetNonce(addr2, 1, tracing.NonceChangeUnspecified)
+
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	if pending, queued := pool.Stats(); pending != 2 || queued != 1 {
+		t.Fatalf("restored pool size mismatch: have %d/%d, want %d/%d", pending, queued, 2, 1)
+	}
+	for _, tx := range []*types.Transaction{pending[0], pending[1], queued} {
+		restored := pool.Get(tx.Hash())
+		if restored == nil {
+			t.Fatalf("transaction %x not restored", tx.Hash())
+		}
+		if !restored.Time().Equal(arrival) {
+			t.Errorf("transaction %x arrival time mismatch: have %v, want %v", tx.Hash(), restored.Time(), arrival)
+		}
+	}
+	if pool.Has(pending[2].Hash()) {
+		t.Errorf("included transaction restored")
+	}
+	if pool.Has(stale.Hash()) {
+		t.Errorf("stale transaction restored")
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
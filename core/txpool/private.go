@@ -0,0 +1,98 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// AddPrivate inserts a transaction into the pool for local block building only.
+// The transaction is never announced or broadcast to the network, and it is
+// dropped from the pool if it's not included until the given block number.
+func (p *TxPool) AddPrivate(tx *types.Transaction, maxBlock uint64) error {
+	if head := p.chain.CurrentBlock(); maxBlock <= head.Number.Uint64() {
+		return ErrPrivateTxExpired
+	}
+	// Mark the transaction private before adding it, the network layer will be
+	// notified about its arrival right away.
+	hash := tx.Hash()
+
+	p.privateLock.Lock()
+	prev, known := p.private[hash]
+	p.private[hash] = maxBlock
+	p.privateLock.Unlock()
+
+	if err := p.Add([]*types.Transaction{tx}, false)[0]; err != nil {
+		p.privateLock.Lock()
+		if known {
+			p.private[hash] = prev
+		} else {
+			delete(p.private, hash)
+		}
+		p.privateLock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// IsPrivate returns whether the transaction with the given hash was submitted
+// privately or with a condition, and must not be propagated to the network.
+func (p *TxPool) IsPrivate(hash common.Hash) bool {
+	return p.isPrivate(hash) || p.Conditional(hash) != nil
+}
+
+// isPrivate returns whether the transaction with the given hash was submitted
+// privately.
+func (p *TxPool) isPrivate(hash common.Hash) bool {
+	p.privateLock.RLock()
+	defer p.privateLock.RUnlock()
+
+	_, ok := p.private[hash]
+	return ok
+}
+
+// expirePrivate drops the private transactions which can't be included in the
+// block following the given head anymore, and forgets about the ones that left
+// the pool otherwise.
+func (p *TxPool) expirePrivate(head *types.Header) {
+	var expired []common.Hash
+
+	p.privateLock.Lock()
+	for hash, maxBlock := range p.private {
+		switch {
+		case maxBlock <= head.Number.Uint64():
+			expired = append(expired, hash)
+			delete(p.private, hash)
+		case !p.Has(hash):
+			delete(p.private, hash)
+		}
+	}
+	p.privateLock.Unlock()
+
+	p.dropPrivate(expired)
+}
+
+// dropPrivate removes the given private transactions from the subpools.
+func (p *TxPool) dropPrivate(hashes []common.Hash) {
+	for _, hash := range hashes {
+		if p.drop(hash, DropExpired) {
+			log.Debug("Dropped private transaction", "hash", hash)
+		}
+	}
+}
@@ -56,6 +56,16 @@ func (r *ReservationTracker) NewHandle(id int) *ReservationHandle {
 	return &ReservationHandle{r, id}
 }
 
+// owner returns the id of the handle holding the reservation of an account, if
+// any.
+func (r *ReservationTracker) owner(addr common.Address) (int, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	id, exists := r.accounts[addr]
+	return id, exists
+}
+
 // Reserver is an interface for creating and releasing owned reservations in the
 // ReservationTracker struct, which is shared between subpools.
 type Reserver interface {
@@ -0,0 +1,110 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that private and conditional transactions are left out of the periodic
+// snapshots of the subpools, such that they are not restored as ordinary ones
+// after a crash.
+func TestSnapshotExclusions(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		gspec  = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  types.GenesisAlloc{addr: {Balance: big.NewInt(params.Ether)}},
+		}
+		signer = types.LatestSigner(params.TestChainConfig)
+	)
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), gspec, ethash.NewFaker(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Stop()
+
+	config := legacypool.DefaultConfig
+	config.Journal = ""
+	config.Snapshot = filepath.Join(t.TempDir(), "txpool.rlp")
+	config.SnapshotInterval = time.Second
+
+	pool, err := txpool.New(config.PriceLimit, chain, []txpool.SubPool{legacypool.New(config, chain)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+
+	newTx := func(nonce uint64) *types.Transaction {
+		return types.MustSignNewTx(key, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &addr,
+			Gas:      params.TxGas,
+			GasPrice: big.NewInt(params.InitialBaseFee),
+		})
+	}
+	public, private, conditional := newTx(0), newTx(1), newTx(2)
+	if err := pool.Add([]*types.Transaction{public}, true)[0]; err != nil {
+		t.Fatalf("failed to add public transaction: %v", err)
+	}
+	if err := pool.AddPrivate(private, 100); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	maxBlock := uint64(100)
+	if err := pool.AddConditional(conditional, &txpool.TxConditional{BlockNumberMax: &maxBlock}); err != nil {
+		t.Fatalf("failed to add conditional transaction: %v", err)
+	}
+	// Wait for the periodic snapshot and restore it into a fresh pool, as if the
+	// node crashed meanwhile
+	for start := time.Now(); ; time.Sleep(50 * time.Millisecond) {
+		if _, err := os.Stat(config.Snapshot); err == nil {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("pool snapshot not written")
+		}
+	}
+	restored := legacypool.New(config, chain)
+	if err := restored.Init(config.PriceLimit, chain.CurrentBlock(), txpool.NewReservationTracker().NewHandle(0)); err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+
+	if !restored.Has(public.Hash()) {
+		t.Error("public transaction not restored")
+	}
+	if restored.Has(private.Hash()) {
+		t.Error("private transaction restored")
+	}
+	if restored.Has(conditional.Hash()) {
+		t.Error("conditional transaction restored")
+	}
+}
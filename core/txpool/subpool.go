@@ -90,6 +90,15 @@ type TxMetadata struct {
 	Size uint64 // The length of the 'rlp encoding' of a transaction
 }
 
+// Snapshotter is implemented by subpools persisting their transactions to disk
+// periodically, to restore them after a restart.
+type Snapshotter interface {
+	// SetSnapshotFilter sets the callback telling which transactions must not be
+	// persisted, as they are not supposed to outlive the running node. It must
+	// be called before Init.
+	SetSnapshotFilter(exclude func(hash common.Hash) bool)
+}
+
 // SubPool represents a specialized transaction pool that lives on its own (e.g.
 // blob pool). Since independent of how many specialized pools we have, they do
 // need to be updated in lockstep and assemble into one coherent view for block
@@ -161,6 +170,17 @@ type SubPool interface {
 	// or also for reorged out ones.
 	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
 
+	// Drop removes a transaction from the pool. Transactions of the same sender
+	// depending on it are removed or become non-executable, depending on the
+	// subpool. It returns whether the transaction was found.
+	Drop(hash common.Hash, reason DropReason) bool
+
+	// SubscribeTxEvents subscribes to transaction lifecycle events, reporting
+	// the additions, promotions, replacements, drops and inclusions of pooled
+	// transactions. Events are delivered asynchronously, subscribers which fall
+	// too far behind are disconnected with ErrTxEventsTooSlow.
+	SubscribeTxEvents(ch chan<- []TxEvent) event.Subscription
+
 	// Nonce returns the next nonce of an account, with all transactions executable
 	// by the pool already applied on top.
 	Nonce(addr common.Address) uint64
@@ -181,6 +201,10 @@ type SubPool interface {
 	// identified by their hashes.
 	Status(hash common.Hash) TxStatus
 
+	// Diagnose reports the status of the pooled transactions of an account along
+	// with the reasons holding them back.
+	Diagnose(addr common.Address) []*TxDiagnosis
+
 	// Clear removes all tracked transactions from the pool
 	Clear()
 }
@@ -17,9 +17,11 @@
 package txpool
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -63,7 +65,8 @@ type BlockChain interface {
 // They exit the pool when they are included in the blockchain or evicted due to
 // resource constraints.
 type TxPool struct {
-	subpools []SubPool // List of subpools for specialized transaction handling
+	subpools []SubPool           // List of subpools for specialized transaction handling
+	reserver *ReservationTracker // Account reservations of the subpools
 	chain    BlockChain
 
 	stateLock sync.RWMutex   // The lock for protecting state instance
@@ -74,6 +77,12 @@ type TxPool struct {
 	term chan struct{}           // Termination channel to detect a closed pool
 
 	sync chan chan error // Testing / simulator channel to block until internal reset is done
+
+	privateLock sync.RWMutex           // Lock protecting the set of private transactions
+	private     map[common.Hash]uint64 // Privately submitted transactions and their last includable block
+
+	conditionalLock sync.RWMutex                   // Lock protecting the set of conditional transactions
+	conditionals    map[common.Hash]*TxConditional // Conditional transactions and their conditions
 }
 
 // New creates a new transaction pool to gather, sort and filter inbound
@@ -96,15 +105,21 @@ func New(gasTip uint64, chain BlockChain, subpools []SubPool) (*TxPool, error) {
 	}
 	pool := &TxPool{
 		subpools: subpools,
+		reserver: NewReservationTracker(),
 		chain:    chain,
 		state:    statedb,
 		quit:     make(chan chan error),
 		term:     make(chan struct{}),
 		sync:     make(chan chan error),
+		private:  make(map[common.Hash]uint64),
+
+		conditionals: make(map[common.Hash]*TxConditional),
 	}
-	reserver := NewReservationTracker()
 	for i, subpool := range subpools {
-		if err := subpool.Init(gasTip, head, reserver.NewHandle(i)); err != nil {
+		if snapshotter, ok := subpool.(Snapshotter); ok {
+			snapshotter.SetSnapshotFilter(pool.IsPrivate)
+		}
+		if err := subpool.Init(gasTip, head, pool.reserver.NewHandle(i)); err != nil {
 			for j := i - 1; j >= 0; j-- {
 				subpools[j].Close()
 			}
@@ -125,6 +140,28 @@ func (p *TxPool) Close() error {
 	if err := <-errc; err != nil {
 		errs = append(errs, err)
 	}
+	// Drop all private and conditional transactions, they must not survive a
+	// restart through the persisted pool contents as they'd be propagated, and
+	// included unconditionally afterwards
+	p.privateLock.Lock()
+	private := make([]common.Hash, 0, len(p.private))
+	for hash := range p.private {
+		private = append(private, hash)
+	}
+	p.private = make(map[common.Hash]uint64)
+	p.privateLock.Unlock()
+
+	p.dropPrivate(private)
+
+	p.conditionalLock.Lock()
+	conditionals := p.conditionals
+	p.conditionals = make(map[common.Hash]*TxConditional)
+	p.conditionalLock.Unlock()
+
+	for hash := range conditionals {
+		p.drop(hash, DropConditionFailed)
+	}
+
 	// Terminate each subpool
 	for _, subpool := range p.subpools {
 		if err := subpool.Close(); err != nil {
@@ -198,6 +235,8 @@ func (p *TxPool) loop(head *types.Header) {
 					for _, subpool := range p.subpools {
 						subpool.Reset(oldHead, newHead)
 					}
+					p.expirePrivate(newHead)
+					p.recheckConditionals(newHead)
 					select {
 					case resetDone <- newHead:
 					case <-p.term:
@@ -379,6 +418,16 @@ func (p *TxPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool)
 	return p.subs.Track(event.JoinSubscriptions(subs...))
 }
 
+// SubscribeTxEvents registers a subscription for the lifecycle events of the
+// transactions in all subpools.
+func (p *TxPool) SubscribeTxEvents(ch chan<- []TxEvent) event.Subscription {
+	subs := make([]event.Subscription, len(p.subpools))
+	for i, subpool := range p.subpools {
+		subs[i] = subpool.SubscribeTxEvents(ch)
+	}
+	return p.subs.Track(event.JoinSubscriptions(subs...))
+}
+
 // PoolNonce returns the next nonce of an account, with all transactions executable
 // by the pool already applied on top.
 func (p *TxPool) PoolNonce(addr common.Address) uint64 {
@@ -459,6 +508,34 @@ func (p *TxPool) Status(hash common.Hash) TxStatus {
 	return TxStatusUnknown
 }
 
+// Diagnose reports the status of the pooled transactions of an account along
+// with the reasons holding them back.
+func (p *TxPool) Diagnose(addr common.Address) *AccountDiagnosis {
+	p.stateLock.RLock()
+	diag := &AccountDiagnosis{
+		Nonce:   p.state.GetNonce(addr),
+		Balance: p.state.GetBalance(addr).Clone(),
+	}
+	p.stateLock.RUnlock()
+
+	for _, subpool := range p.subpools {
+		diag.Txs = append(diag.Txs, subpool.Diagnose(addr)...)
+	}
+	slices.SortStableFunc(diag.Txs, func(a, b *TxDiagnosis) int {
+		return cmp.Compare(a.Nonce, b.Nonce)
+	})
+	// Transactions of types handled by a subpool other than the reservation
+	// owner are rejected until the owner's transactions are gone.
+	if owner, ok := p.reserver.owner(addr); ok {
+		for _, kind := range []uint8{types.LegacyTxType, types.AccessListTxType, types.DynamicFeeTxType, types.BlobTxType, types.SetCodeTxType} {
+			if !p.subpools[owner].FilterType(kind) {
+				diag.RejectedTypes = append(diag.RejectedTypes, kind)
+			}
+		}
+	}
+	return diag
+}
+
 // Sync is a helper method for unit tests or simulator runs where the chain events
 // are arriving in quick succession, without any time in between them to run the
 // internal background reset operations. This method will run an explicit reset
@@ -488,6 +565,13 @@ func (p *TxPool) Clear() {
 	for _, subpool := range p.subpools {
 		subpool.Clear()
 	}
+	p.privateLock.Lock()
+	p.private = make(map[common.Hash]uint64)
+	p.privateLock.Unlock()
+
+	p.conditionalLock.Lock()
+	p.conditionals = make(map[common.Hash]*TxConditional)
+	p.conditionalLock.Unlock()
 }
 
 // FilterType returns whether a transaction with the given type is supported
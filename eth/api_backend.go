@@ -33,6 +33,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/txpool/locals"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -282,6 +284,10 @@ func (b *EthAPIBackend) GetReceipts(ctx context.Context, hash common.Hash) (type
 	return b.eth.blockchain.GetReceiptsByHash(hash), nil
 }
 
+func (b *EthAPIBackend) GetBlobSidecars(ctx context.Context, block *types.Block) ([]*blobpool.ArchivedSidecar, error) {
+	return b.eth.blobTxPool.GetBlobSidecars(block)
+}
+
 func (b *EthAPIBackend) GetCanonicalReceipt(tx *types.Transaction, blockHash common.Hash, blockNumber, blockIndex uint64) (*types.Receipt, error) {
 	return b.eth.blockchain.GetCanonicalReceipt(tx, blockHash, blockNumber, blockIndex)
 }
@@ -346,6 +352,26 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 	return nil
 }
 
+// SendPrivateTx adds a transaction to the pool without ever announcing it to the
+// network. Private transactions are not tracked as locals, as resubmitting them
+// after expiry would defeat the requested inclusion deadline.
+func (b *EthAPIBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, maxBlock uint64) error {
+	return b.eth.txPool.AddPrivate(signedTx, maxBlock)
+}
+
+// SendConditionalTx adds a transaction to the pool which may only be included in
+// blocks satisfying the given condition. Like private transactions, conditional
+// ones are neither propagated nor tracked as locals.
+func (b *EthAPIBackend) SendConditionalTx(ctx context.Context, signedTx *types.Transaction, cond *txpool.TxConditional) error {
+	return b.eth.txPool.AddConditional(signedTx, cond)
+}
+
+// SendBundle adds a transaction bundle to the bundle pool, to be included by the
+// local miner.
+func (b *EthAPIBackend) SendBundle(ctx context.Context, bundle *bundlepool.Bundle) error {
+	return b.eth.bundlePool.Add(bundle)
+}
+
 func (b *EthAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	pending := b.eth.txPool.Pending(txpool.PendingFilter{})
 	var txs types.Transactions
@@ -359,6 +385,10 @@ func (b *EthAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	return txs, nil
 }
 
+func (b *EthAPIBackend) TxPoolPending(filter txpool.PendingFilter) map[common.Address][]*txpool.LazyTransaction {
+	return b.eth.txPool.Pending(filter)
+}
+
 func (b *EthAPIBackend) GetPoolTransaction(hash common.Hash) *types.Transaction {
 	return b.eth.txPool.Get(hash)
 }
@@ -400,6 +430,10 @@ func (b *EthAPIBackend) TxPoolContentFrom(addr common.Address) ([]*types.Transac
 	return b.eth.txPool.ContentFrom(addr)
 }
 
+func (b *EthAPIBackend) TxPoolDiagnose(addr common.Address) *txpool.AccountDiagnosis {
+	return b.eth.txPool.Diagnose(addr)
+}
+
 func (b *EthAPIBackend) TxPool() *txpool.TxPool {
 	return b.eth.txPool
 }
@@ -408,6 +442,10 @@ func (b *EthAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.S
 	return b.eth.txPool.SubscribeTransactions(ch, true)
 }
 
+func (b *EthAPIBackend) SubscribeTxPoolEvents(ch chan<- []txpool.TxEvent) event.Subscription {
+	return b.eth.txPool.SubscribeTxEvents(ch)
+}
+
 func (b *EthAPIBackend) SyncProgress(ctx context.Context) ethereum.SyncProgress {
 	prog := b.eth.Downloader().Progress()
 	if txProg, err := b.eth.blockchain.TxIndexProgress(); err == nil {
@@ -436,6 +474,10 @@ func (b *EthAPIBackend) BlobBaseFee(ctx context.Context) *big.Int {
 	return nil
 }
 
+func (b *EthAPIBackend) FeeEstimates(ctx context.Context) ([]gasprice.FeeEstimate, error) {
+	return b.gpo.FeeEstimates(ctx)
+}
+
 func (b *EthAPIBackend) ChainDb() ethdb.Database {
 	return b.eth.ChainDb()
 }
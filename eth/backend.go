@@ -37,6 +37,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/state/pruner"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/core/txpool/locals"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -94,6 +95,7 @@ type Ethereum struct {
 	config         *ethconfig.Config
 	txPool         *txpool.TxPool
 	blobTxPool     *blobpool.BlobPool
+	bundlePool     *bundlepool.BundlePool
 	localTxTracker *locals.TxTracker
 	blockchain     *core.BlockChain
 
@@ -118,6 +120,7 @@ type Ethereum struct {
 
 	networkID     uint64
 	netRPCService *ethapi.NetAPI
+	tracerAPIs    []rpc.API // APIs exposed by the live tracer
 
 	p2pServer *p2p.Server
 
@@ -171,6 +174,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb); err != nil {
 			log.Error("Failed to recover state", "error", err)
 		}
+		if config.StateHistoryIndex {
+			log.Warn("State history indexing is only supported in path scheme", "scheme", scheme)
+		}
 	}
 
 	// Here we determine genesis hash and active ChainConfig.
@@ -230,6 +236,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			SnapshotLimit:           config.SnapshotCache,
 			Preimages:               config.Preimages,
 			StateHistory:            config.StateHistory,
+			StateHistoryIndex:       config.StateHistoryIndex,
 			TrienodeHistory:         config.TrienodeHistory,
 			NodeFullValueCheckpoint: config.NodeFullValueCheckpoint,
 			StateScheme:             scheme,
@@ -246,6 +253,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			// - DATADIR/triedb/verkle.journal
 			TrieJournalDirectory: stack.ResolvePath("triedb"),
 			StateSizeTracking:    config.EnableStateSizeTracking,
+			StateFork:            config.StateFork,
 			SlowBlockThreshold:   config.SlowBlockThreshold,
 		}
 	)
@@ -254,11 +262,12 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		if config.VMTraceJsonConfig != "" {
 			traceConfig = json.RawMessage(config.VMTraceJsonConfig)
 		}
-		t, err := tracers.LiveDirectory.New(config.VMTrace, traceConfig)
+		t, apis, err := tracers.LiveDirectory.NewWithAPIs(config.VMTrace, traceConfig)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create tracer %s: %v", config.VMTrace, err)
 		}
 		options.VmConfig.Tracer = t
+		eth.tracerAPIs = apis
 	}
 	// Override the chain config with provided settings.
 	var overrides core.ChainOverrides
@@ -280,6 +289,11 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	for _, api := range eth.tracerAPIs {
+		if service, ok := api.Service.(tracers.ChainAware); ok {
+			service.SetChain(eth.blockchain)
+		}
+	}
 
 	// Initialize filtermaps log index.
 	fmConfig := filtermaps.Config{
@@ -305,6 +319,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.Snapshot != "" {
+		config.TxPool.Snapshot = stack.ResolvePath(config.TxPool.Snapshot)
+	}
 	legacyPool := legacypool.New(config.TxPool, eth.blockchain)
 
 	if config.BlobPool.Datadir != "" {
@@ -316,6 +333,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	eth.bundlePool = bundlepool.New(eth.blockchain)
 
 	if !config.TxPool.NoLocals {
 		rejournal := config.TxPool.Rejournal
@@ -345,6 +363,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 
 	eth.dropper = newDropper(eth.p2pServer.MaxDialedConns(), eth.p2pServer.MaxInboundConns())
 
+	if _, err := miner.LookupOrderingPolicy(config.Miner.Ordering); err != nil {
+		return nil, err
+	}
 	eth.miner = miner.New(eth, config.Miner, eth.engine)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
 	eth.miner.SetPrioAddresses(config.TxPool.Locals)
@@ -391,6 +412,9 @@ func makeExtraData(extra []byte) []byte {
 func (s *Ethereum) APIs() []rpc.API {
 	apis := ethapi.GetAPIs(s.APIBackend)
 
+	// Append the APIs of the live tracer, if any
+	apis = append(apis, s.tracerAPIs...)
+
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
 		{
@@ -422,6 +446,7 @@ func (s *Ethereum) AccountManager() *accounts.Manager  { return s.accountManager
 func (s *Ethereum) BlockChain() *core.BlockChain       { return s.blockchain }
 func (s *Ethereum) TxPool() *txpool.TxPool             { return s.txPool }
 func (s *Ethereum) BlobTxPool() *blobpool.BlobPool     { return s.blobTxPool }
+func (s *Ethereum) BundlePool() *bundlepool.BundlePool { return s.bundlePool }
 func (s *Ethereum) Engine() consensus.Engine           { return s.engine }
 func (s *Ethereum) ChainDb() ethdb.Database            { return s.chainDb }
 func (s *Ethereum) IsListening() bool                  { return true } // Always listening
@@ -583,6 +608,7 @@ func (s *Ethereum) Stop() error {
 	<-ch
 	s.filterMaps.Stop()
 	s.txPool.Close()
+	s.bundlePool.Close()
 	s.blockchain.Stop()
 	s.engine.Close()
 
@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/history"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -45,6 +46,7 @@ var (
 	errExceedMaxTopics        = errors.New("exceed max topics")
 	errExceedLogQueryLimit    = errors.New("exceed max addresses or topics per search position")
 	errExceedMaxTxHashes      = errors.New("exceed max number of transaction hashes allowed per transactionReceipts subscription")
+	errExceedMaxTxPoolFilters = errors.New("exceed max number of senders or transaction hashes allowed per txpoolEvents subscription")
 )
 
 type invalidParamsError struct {
@@ -314,6 +316,89 @@ func (api *FilterAPI) Logs(ctx context.Context, crit FilterCriteria) (*rpc.Subsc
 	return rpcSub, nil
 }
 
+// TxPoolEventsQuery defines criteria for the transaction pool events subscription.
+// Events match if either their sender or their transaction hash is listed.
+type TxPoolEventsQuery struct {
+	From              []common.Address `json:"from"`
+	TransactionHashes []common.Hash    `json:"transactionHashes"`
+}
+
+// rpcTxPoolEvent is the JSON representation of a transaction pool event.
+type rpcTxPoolEvent struct {
+	Type        string          `json:"type"`
+	Hash        common.Hash     `json:"hash"`
+	From        common.Address  `json:"from"`
+	Nonce       hexutil.Uint64  `json:"nonce"`
+	Reason      string          `json:"reason,omitempty"`
+	ReplacedBy  *common.Hash    `json:"replacedBy,omitempty"`
+	BlockHash   *common.Hash    `json:"blockHash,omitempty"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"`
+}
+
+func newRPCTxPoolEvent(ev txpool.TxEvent) *rpcTxPoolEvent {
+	result := &rpcTxPoolEvent{
+		Type:  ev.Type.String(),
+		Hash:  ev.Hash,
+		From:  ev.From,
+		Nonce: hexutil.Uint64(ev.Nonce),
+	}
+	switch ev.Type {
+	case txpool.TxEventDropped:
+		result.Reason = ev.Reason.String()
+	case txpool.TxEventReplaced:
+		result.ReplacedBy = &ev.ReplacedBy
+	case txpool.TxEventIncluded:
+		number := hexutil.Uint64(ev.BlockNumber)
+		result.BlockHash, result.BlockNumber = &ev.BlockHash, &number
+	}
+	return result
+}
+
+// TxpoolEvents creates a subscription that fires the lifecycle events of pooled
+// transactions: additions, promotions, replacements, drops along with their reason
+// and inclusions into blocks.
+func (api *FilterAPI) TxpoolEvents(ctx context.Context, query *TxPoolEventsQuery) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	var (
+		senders  []common.Address
+		txHashes []common.Hash
+	)
+	if query != nil {
+		senders, txHashes = query.From, query.TransactionHashes
+	}
+	if len(senders)+len(txHashes) > maxTxHashes {
+		return nil, errExceedMaxTxPoolFilters
+	}
+	var (
+		rpcSub = notifier.CreateSubscription()
+		events = make(chan []txpool.TxEvent)
+	)
+	eventsSub := api.events.SubscribeTxPoolEvents(senders, txHashes, events)
+
+	go func() {
+		defer eventsSub.Unsubscribe()
+
+		for {
+			select {
+			case evs := <-events:
+				// Send a batch of events in one notification
+				batch := make([]*rpcTxPoolEvent, len(evs))
+				for i, ev := range evs {
+					batch[i] = newRPCTxPoolEvent(ev)
+				}
+				notifier.Notify(rpcSub.ID, batch)
+			case <-rpcSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // TransactionReceiptsQuery defines criteria for transaction receipts subscription.
 // Same as ethereum.TransactionReceiptsQuery but with UnmarshalJSON() method.
 type TransactionReceiptsQuery ethereum.TransactionReceiptsQuery
@@ -440,7 +525,38 @@ func (api *FilterAPI) NewFilter(crit FilterCriteria) (rpc.ID, error) {
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
-func (api *FilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*types.Log, error) {
+// The logs are streamed to the client to avoid encoding large results in memory.
+// Paginated queries are served by GetLogsPage.
+func (api *FilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) (*rpc.ArrayStream[*types.Log], error) {
+	if crit.Cursor != "" || crit.Limit != 0 {
+		return nil, errPaginationUnsupported
+	}
+	filter, err := api.logsFilter(crit)
+	if err != nil {
+		return nil, err
+	}
+	// Run the filter and stream the logs as the range is searched
+	logs, err := filter.LogsSeq(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.NewArrayStream(logs), nil
+}
+
+// GetLogsPage returns a single page of the logs matching the given argument,
+// along with the cursor to continue the query with the next page. The first page
+// is requested without a cursor. If no limit is given, pages hold up to 1000 logs.
+func (api *FilterAPI) GetLogsPage(ctx context.Context, crit FilterCriteria) (*LogPage, error) {
+	filter, err := api.logsFilter(crit)
+	if err != nil {
+		return nil, err
+	}
+	return api.logsPage(ctx, filter, crit)
+}
+
+// logsFilter validates the criteria of a log query and creates the filter
+// running it.
+func (api *FilterAPI) logsFilter(crit FilterCriteria) (*Filter, error) {
 	if len(crit.Topics) > maxTopics {
 		return nil, errExceedMaxTopics
 	}
@@ -483,13 +599,34 @@ func (api *FilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*type
 		// Construct the range filter
 		filter = api.sys.NewRangeFilter(begin, end, crit.Addresses, crit.Topics, api.rangeLimit)
 	}
+	return filter, nil
+}
 
-	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx)
+// logsPage runs the filter for a single page of logs.
+func (api *FilterAPI) logsPage(ctx context.Context, filter *Filter, crit FilterCriteria) (*LogPage, error) {
+	limit := crit.Limit
+	if limit == 0 {
+		limit = defaultLogPageSize
+	}
+	if limit > maxLogPageSize {
+		return nil, errLogPageTooLarge
+	}
+	var cursor *logCursor
+	if crit.Cursor != "" {
+		var err error
+		if cursor, err = parseLogCursor(crit.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	logs, next, err := filter.logsPage(ctx, cursor, int(limit))
 	if err != nil {
 		return nil, err
 	}
-	return returnLogs(logs), err
+	page := &LogPage{Logs: returnLogs(logs)}
+	if next != nil {
+		page.Cursor = next.String()
+	}
+	return page, nil
 }
 
 // UninstallFilter removes the filter with the given filter id.
@@ -620,6 +757,8 @@ func (args *FilterCriteria) UnmarshalJSON(data []byte) error {
 		ToBlock   *rpc.BlockNumber `json:"toBlock"`
 		Addresses interface{}      `json:"address"`
 		Topics    []interface{}    `json:"topics"`
+		Cursor    string           `json:"cursor"`
+		Limit     *hexutil.Uint64  `json:"limit"`
 	}
 
 	var raw input
@@ -643,6 +782,10 @@ func (args *FilterCriteria) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	args.Cursor = raw.Cursor
+	if raw.Limit != nil {
+		args.Limit = uint64(*raw.Limit)
+	}
 	args.Addresses = []common.Address{}
 
 	if raw.Addresses != nil {
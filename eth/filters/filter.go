@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"math"
 	"math/big"
 	"slices"
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/filtermaps"
 	"github.com/ethereum/go-ethereum/core/history"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -102,47 +104,12 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		return nil, errPendingLogsUnsupported
 	}
 
-	resolveSpecial := func(number int64) (uint64, error) {
-		switch number {
-		case rpc.LatestBlockNumber.Int64():
-			// when searching from and/or until the current head, we resolve it
-			// to MaxUint64 which is translated by rangeLogs to the actual head
-			// in each iteration, ensuring that the head block will be searched
-			// even if the chain is updated during search.
-			return math.MaxUint64, nil
-		case rpc.FinalizedBlockNumber.Int64():
-			hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
-			if hdr == nil {
-				return 0, errors.New("finalized header not found")
-			}
-			return hdr.Number.Uint64(), nil
-		case rpc.SafeBlockNumber.Int64():
-			hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.SafeBlockNumber)
-			if hdr == nil {
-				return 0, errors.New("safe header not found")
-			}
-			return hdr.Number.Uint64(), nil
-		case rpc.EarliestBlockNumber.Int64():
-			earliest := f.sys.backend.HistoryPruningCutoff()
-			hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(earliest))
-			if hdr == nil {
-				return 0, errors.New("earliest header not found")
-			}
-			return hdr.Number.Uint64(), nil
-		default:
-			if number < 0 {
-				return 0, errors.New("negative block number")
-			}
-			return uint64(number), nil
-		}
-	}
-
 	// range query need to resolve the special begin/end block number
-	begin, err := resolveSpecial(f.begin)
+	begin, err := f.resolveSpecial(ctx, f.begin)
 	if err != nil {
 		return nil, err
 	}
-	end, err := resolveSpecial(f.end)
+	end, err := f.resolveSpecial(ctx, f.end)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +119,120 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	return f.rangeLogs(ctx, begin, end)
 }
 
+// LogsSeq returns an iterator over the logs matching the filter criteria. The
+// criteria are validated up front, but the range is searched in sections while
+// the iterator is consumed, such that only the logs of a single section are held
+// in memory at once. Iteration stops at the first error.
+func (f *Filter) LogsSeq(ctx context.Context) (iter.Seq2[*types.Log, error], error) {
+	if f.block != nil {
+		logs, err := f.Logs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return logsSeq(logs), nil
+	}
+	if f.begin == rpc.PendingBlockNumber.Int64() || f.end == rpc.PendingBlockNumber.Int64() {
+		return nil, errPendingLogsUnsupported
+	}
+	begin, err := f.resolveSpecial(ctx, f.begin)
+	if err != nil {
+		return nil, err
+	}
+	end, err := f.resolveSpecial(ctx, f.end)
+	if err != nil {
+		return nil, err
+	}
+	if f.rangeLimit != 0 && (end-begin) > f.rangeLimit {
+		return nil, fmt.Errorf("exceed maximum block range: %d", f.rangeLimit)
+	}
+	// Split the range at the current head. Sections are searched in ascending
+	// order, the last one is resolved against the head at the time of its search.
+	last := end
+	if last == math.MaxUint64 {
+		view := f.sys.backend.CurrentView()
+		if view == nil {
+			return nil, errors.New("head block not available")
+		}
+		last = view.HeadNumber()
+	}
+	if begin == math.MaxUint64 || begin > last || last-begin < logPageSearchRange {
+		logs, err := f.rangeLogs(ctx, begin, end)
+		if err != nil {
+			return nil, err
+		}
+		return logsSeq(logs), nil
+	}
+	return func(yield func(*types.Log, error) bool) {
+		for from := begin; ; from += logPageSearchRange {
+			to := end
+			if last-from >= logPageSearchRange {
+				to = from + logPageSearchRange - 1
+			}
+			logs, err := f.rangeLogs(ctx, from, to)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, log := range logs {
+				if !yield(log, nil) {
+					return
+				}
+			}
+			if to == end {
+				return
+			}
+		}
+	}, nil
+}
+
+// logsSeq returns an iterator over a slice of logs.
+func logsSeq(logs []*types.Log) iter.Seq2[*types.Log, error] {
+	return func(yield func(*types.Log, error) bool) {
+		for _, log := range logs {
+			if !yield(log, nil) {
+				return
+			}
+		}
+	}
+}
+
+// resolveSpecial resolves a special block number of the queried range into an
+// absolute one.
+func (f *Filter) resolveSpecial(ctx context.Context, number int64) (uint64, error) {
+	switch number {
+	case rpc.LatestBlockNumber.Int64():
+		// when searching from and/or until the current head, we resolve it
+		// to MaxUint64 which is translated by rangeLogs to the actual head
+		// in each iteration, ensuring that the head block will be searched
+		// even if the chain is updated during search.
+		return math.MaxUint64, nil
+	case rpc.FinalizedBlockNumber.Int64():
+		hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+		if hdr == nil {
+			return 0, errors.New("finalized header not found")
+		}
+		return hdr.Number.Uint64(), nil
+	case rpc.SafeBlockNumber.Int64():
+		hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.SafeBlockNumber)
+		if hdr == nil {
+			return 0, errors.New("safe header not found")
+		}
+		return hdr.Number.Uint64(), nil
+	case rpc.EarliestBlockNumber.Int64():
+		earliest := f.sys.backend.HistoryPruningCutoff()
+		hdr, _ := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(earliest))
+		if hdr == nil {
+			return 0, errors.New("earliest header not found")
+		}
+		return hdr.Number.Uint64(), nil
+	default:
+		if number < 0 {
+			return 0, errors.New("negative block number")
+		}
+		return uint64(number), nil
+	}
+}
+
 const (
 	rangeLogsTestDone      = iota // zero range
 	rangeLogsTestSync             // before sync; zero range
@@ -610,3 +691,22 @@ func filterReceipts(txHashes map[common.Hash]struct{}, ev core.ChainEvent) []*Re
 
 	return ret
 }
+
+// filterTxPoolEvents returns the transaction pool events matching the given
+// senders or transaction hashes. Without any criteria, all events match.
+func filterTxPoolEvents(senders map[common.Address]struct{}, txHashes map[common.Hash]struct{}, events []txpool.TxEvent) []txpool.TxEvent {
+	if len(senders) == 0 && len(txHashes) == 0 {
+		return events
+	}
+	var ret []txpool.TxEvent
+	for _, ev := range events {
+		if _, ok := senders[ev.From]; ok {
+			ret = append(ret, ev)
+			continue
+		}
+		if _, ok := txHashes[ev.Hash]; ok {
+			ret = append(ret, ev)
+		}
+	}
+	return ret
+}
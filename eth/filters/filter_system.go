@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/filtermaps"
 	"github.com/ethereum/go-ethereum/core/history"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
@@ -72,6 +73,7 @@ type Backend interface {
 	SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
 	SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription
 	SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
+	SubscribeTxPoolEvents(ch chan<- []txpool.TxEvent) event.Subscription
 
 	CurrentView() *filtermaps.ChainView
 	NewMatcherBackend() filtermaps.MatcherBackend
@@ -161,6 +163,8 @@ const (
 	BlocksSubscription
 	// TransactionReceiptsSubscription queries for transaction receipts when transactions are included in blocks
 	TransactionReceiptsSubscription
+	// TxPoolEventsSubscription queries for lifecycle events of pooled transactions
+	TxPoolEventsSubscription
 	// LastIndexSubscription keeps track of the last index
 	LastIndexSubscription
 )
@@ -175,6 +179,12 @@ const (
 	logsChanSize = 10
 	// chainEvChanSize is the size of channel listening to ChainEvent.
 	chainEvChanSize = 10
+	// txEventsChanSize is the size of channel listening to transaction pool
+	// lifecycle events.
+	txEventsChanSize = 100
+	// txEventsQueueSize is the number of lifecycle event batches queued for a
+	// single subscription. Further batches are dropped until it catches up.
+	txEventsQueueSize = 256
 )
 
 type subscription struct {
@@ -186,9 +196,13 @@ type subscription struct {
 	txs       chan []*types.Transaction
 	headers   chan *types.Header
 	receipts  chan []*ReceiptWithTx
-	txHashes  map[common.Hash]struct{} // contains transaction hashes for transactionReceipts subscription filtering
-	installed chan struct{}            // closed when the filter is installed
-	err       chan error               // closed when the filter is uninstalled
+	txEvents  chan []txpool.TxEvent
+	txQueue   chan []txpool.TxEvent       // queue of lifecycle events not yet forwarded to txEvents
+	txDropped bool                        // whether lifecycle events were dropped, only accessed by the event loop
+	txHashes  map[common.Hash]struct{}    // contains transaction hashes for transactionReceipts and txpoolEvents subscription filtering
+	txSenders map[common.Address]struct{} // contains transaction senders for txpoolEvents subscription filtering
+	installed chan struct{}               // closed when the filter is installed
+	err       chan error                  // closed when the filter is uninstalled
 }
 
 // EventSystem creates subscriptions, processes events and broadcasts them to the
@@ -202,6 +216,7 @@ type EventSystem struct {
 	logsSub   event.Subscription // Subscription for new log event
 	rmLogsSub event.Subscription // Subscription for removed log event
 	chainSub  event.Subscription // Subscription for new chain event
+	txEvSub   event.Subscription // Subscription for transaction pool lifecycle events
 
 	// Channels
 	install   chan *subscription         // install filter for event notification
@@ -210,6 +225,7 @@ type EventSystem struct {
 	logsCh    chan []*types.Log          // Channel to receive new log event
 	rmLogsCh  chan core.RemovedLogsEvent // Channel to receive removed log event
 	chainCh   chan core.ChainEvent       // Channel to receive new chain event
+	txEvCh    chan []txpool.TxEvent      // Channel to receive transaction pool lifecycle events
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -228,6 +244,7 @@ func NewEventSystem(sys *FilterSystem) *EventSystem {
 		logsCh:    make(chan []*types.Log, logsChanSize),
 		rmLogsCh:  make(chan core.RemovedLogsEvent, rmLogsChanSize),
 		chainCh:   make(chan core.ChainEvent, chainEvChanSize),
+		txEvCh:    make(chan []txpool.TxEvent, txEventsChanSize),
 	}
 
 	// Subscribe events
@@ -235,9 +252,10 @@ func NewEventSystem(sys *FilterSystem) *EventSystem {
 	m.logsSub = m.backend.SubscribeLogsEvent(m.logsCh)
 	m.rmLogsSub = m.backend.SubscribeRemovedLogsEvent(m.rmLogsCh)
 	m.chainSub = m.backend.SubscribeChainEvent(m.chainCh)
+	m.txEvSub = m.backend.SubscribeTxPoolEvents(m.txEvCh)
 
 	// Make sure none of the subscriptions are empty
-	if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil {
+	if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil || m.txEvSub == nil {
 		log.Crit("Subscribe for event system failed")
 	}
 
@@ -274,6 +292,7 @@ func (sub *Subscription) Unsubscribe() {
 			case <-sub.f.txs:
 			case <-sub.f.headers:
 			case <-sub.f.receipts:
+			case <-sub.f.txEvents:
 			}
 		}
 
@@ -295,6 +314,9 @@ func (es *EventSystem) subscribe(sub *subscription) *Subscription {
 // given criteria to the given logs channel. Default value for the from and to
 // block is "latest". If the fromBlock > toBlock an error is returned.
 func (es *EventSystem) SubscribeLogs(crit ethereum.FilterQuery, logs chan []*types.Log) (*Subscription, error) {
+	if crit.Cursor != "" || crit.Limit != 0 {
+		return nil, errPaginationUnsupported
+	}
 	if len(crit.Topics) > maxTopics {
 		return nil, errExceedMaxTopics
 	}
@@ -360,6 +382,7 @@ func (es *EventSystem) subscribeLogs(crit ethereum.FilterQuery, logs chan []*typ
 		txs:       make(chan []*types.Transaction),
 		headers:   make(chan *types.Header),
 		receipts:  make(chan []*ReceiptWithTx),
+		txEvents:  make(chan []txpool.TxEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -377,6 +400,7 @@ func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscripti
 		txs:       make(chan []*types.Transaction),
 		headers:   headers,
 		receipts:  make(chan []*ReceiptWithTx),
+		txEvents:  make(chan []txpool.TxEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -394,6 +418,7 @@ func (es *EventSystem) SubscribePendingTxs(txs chan []*types.Transaction) *Subsc
 		txs:       txs,
 		headers:   make(chan *types.Header),
 		receipts:  make(chan []*ReceiptWithTx),
+		txEvents:  make(chan []txpool.TxEvent),
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -416,6 +441,7 @@ func (es *EventSystem) SubscribeTransactionReceipts(txHashes []common.Hash, rece
 		txs:       make(chan []*types.Transaction),
 		headers:   make(chan *types.Header),
 		receipts:  receipts,
+		txEvents:  make(chan []txpool.TxEvent),
 		txHashes:  hashSet,
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -423,6 +449,66 @@ func (es *EventSystem) SubscribeTransactionReceipts(txHashes []common.Hash, rece
 	return es.subscribe(sub)
 }
 
+// SubscribeTxPoolEvents creates a subscription that writes the lifecycle events
+// of pooled transactions. If senders or txHashes are provided, only the events
+// of transactions matching either of them are delivered.
+//
+// Events are queued for the subscription and forwarded by a dedicated goroutine,
+// such that a slow subscriber doesn't hold up the event system. If the queue of
+// the subscription is full, further events are dropped.
+func (es *EventSystem) SubscribeTxPoolEvents(senders []common.Address, txHashes []common.Hash, events chan []txpool.TxEvent) *Subscription {
+	var (
+		senderSet map[common.Address]struct{}
+		hashSet   map[common.Hash]struct{}
+	)
+	if len(senders) > 0 {
+		senderSet = make(map[common.Address]struct{}, len(senders))
+		for _, addr := range senders {
+			senderSet[addr] = struct{}{}
+		}
+	}
+	if len(txHashes) > 0 {
+		hashSet = make(map[common.Hash]struct{}, len(txHashes))
+		for _, h := range txHashes {
+			hashSet[h] = struct{}{}
+		}
+	}
+	sub := &subscription{
+		id:        rpc.NewID(),
+		typ:       TxPoolEventsSubscription,
+		created:   time.Now(),
+		logs:      make(chan []*types.Log),
+		txs:       make(chan []*types.Transaction),
+		headers:   make(chan *types.Header),
+		receipts:  make(chan []*ReceiptWithTx),
+		txEvents:  events,
+		txQueue:   make(chan []txpool.TxEvent, txEventsQueueSize),
+		txHashes:  hashSet,
+		txSenders: senderSet,
+		installed: make(chan struct{}),
+		err:       make(chan error),
+	}
+	go sub.forwardTxEvents()
+	return es.subscribe(sub)
+}
+
+// forwardTxEvents moves queued lifecycle events to the channel of the
+// subscription, until it is uninstalled.
+func (sub *subscription) forwardTxEvents() {
+	for {
+		select {
+		case events := <-sub.txQueue:
+			select {
+			case sub.txEvents <- events:
+			case <-sub.err:
+				return
+			}
+		case <-sub.err:
+			return
+		}
+	}
+}
+
 type filterIndex map[Type]map[rpc.ID]*subscription
 
 func (es *EventSystem) handleLogs(filters filterIndex, ev []*types.Log) {
@@ -457,6 +543,23 @@ func (es *EventSystem) handleChainEvent(filters filterIndex, ev core.ChainEvent)
 	}
 }
 
+func (es *EventSystem) handleTxPoolEvents(filters filterIndex, ev []txpool.TxEvent) {
+	for _, f := range filters[TxPoolEventsSubscription] {
+		matched := filterTxPoolEvents(f.txSenders, f.txHashes, ev)
+		if len(matched) == 0 {
+			continue
+		}
+		select {
+		case f.txQueue <- matched:
+		default:
+			if !f.txDropped {
+				log.Warn("Dropping transaction pool events of slow subscriber", "id", f.id)
+				f.txDropped = true
+			}
+		}
+	}
+}
+
 // eventLoop (un)installs filters and processes mux events.
 func (es *EventSystem) eventLoop() {
 	// Ensure all subscriptions get cleaned up
@@ -465,6 +568,7 @@ func (es *EventSystem) eventLoop() {
 		es.logsSub.Unsubscribe()
 		es.rmLogsSub.Unsubscribe()
 		es.chainSub.Unsubscribe()
+		es.txEvSub.Unsubscribe()
 	}()
 
 	index := make(filterIndex)
@@ -482,6 +586,8 @@ func (es *EventSystem) eventLoop() {
 			es.handleLogs(index, ev.Logs)
 		case ev := <-es.chainCh:
 			es.handleChainEvent(index, ev)
+		case ev := <-es.txEvCh:
+			es.handleTxPoolEvents(index, ev)
 
 		case f := <-es.install:
 			index[f.typ][f.id] = f
@@ -500,6 +606,14 @@ func (es *EventSystem) eventLoop() {
 			return
 		case <-es.chainSub.Err():
 			return
+		case err := <-es.txEvSub.Err():
+			if err == nil {
+				return
+			}
+			// The event system fell behind the transaction pool and was
+			// disconnected. Some events are lost, resubscribe to carry on.
+			log.Warn("Transaction pool events lost", "err", err)
+			es.txEvSub = es.backend.SubscribeTxPoolEvents(es.txEvCh)
 		}
 	}
 }
@@ -18,6 +18,7 @@ package filters
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
 	"reflect"
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/filtermaps"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -47,6 +49,7 @@ type testBackend struct {
 	logsFeed        event.Feed
 	rmLogsFeed      event.Feed
 	chainFeed       event.Feed
+	txEventFeed     event.Feed
 	pendingBlock    *types.Block
 	pendingReceipts types.Receipts
 }
@@ -148,6 +151,10 @@ func (b *testBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Sub
 	return b.txFeed.Subscribe(ch)
 }
 
+func (b *testBackend) SubscribeTxPoolEvents(ch chan<- []txpool.TxEvent) event.Subscription {
+	return b.txEventFeed.Subscribe(ch)
+}
+
 func (b *testBackend) SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription {
 	return b.rmLogsFeed.Subscribe(ch)
 }
@@ -922,3 +929,110 @@ func TestTransactionReceiptsSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestTxPoolEventsSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(db, Config{})
+		api          = NewFilterAPI(sys)
+
+		alice = common.HexToAddress("0xa11ce")
+		bob   = common.HexToAddress("0xb0b")
+
+		events = []txpool.TxEvent{
+			{Type: txpool.TxEventAdded, Hash: common.Hash{1}, From: alice},
+			{Type: txpool.TxEventReplaced, Hash: common.Hash{2}, From: bob, ReplacedBy: common.Hash{3}},
+			{Type: txpool.TxEventDropped, Hash: common.Hash{4}, From: bob, Nonce: 1, Reason: txpool.DropAccountLimit},
+			{Type: txpool.TxEventIncluded, Hash: common.Hash{1}, From: alice, BlockHash: common.Hash{5}, BlockNumber: 7},
+		}
+	)
+	testCases := []struct {
+		name     string
+		senders  []common.Address
+		txHashes []common.Hash
+		expected []txpool.TxEvent
+	}{
+		{"no filter", nil, nil, events},
+		{"sender filter", []common.Address{bob}, nil, events[1:3]},
+		{"hash filter", nil, []common.Hash{{1}}, []txpool.TxEvent{events[0], events[3]}},
+		{"sender or hash filter", []common.Address{alice}, []common.Hash{{4}}, []txpool.TxEvent{events[0], events[2], events[3]}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan []txpool.TxEvent)
+			sub := api.events.SubscribeTxPoolEvents(tc.senders, tc.txHashes, ch)
+			defer sub.Unsubscribe()
+
+			backend.txEventFeed.Send(events)
+
+			select {
+			case have := <-ch:
+				if !reflect.DeepEqual(have, tc.expected) {
+					t.Errorf("event mismatch: have %v, want %v", have, tc.expected)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("timeout waiting for events")
+			}
+		})
+	}
+	// Check the RPC representation of the events
+	want := []string{
+		`{"type":"added","hash":"0x0100000000000000000000000000000000000000000000000000000000000000","from":"0x00000000000000000000000000000000000a11ce","nonce":"0x0"}`,
+		`{"type":"replaced","hash":"0x0200000000000000000000000000000000000000000000000000000000000000","from":"0x0000000000000000000000000000000000000b0b","nonce":"0x0","replacedBy":"0x0300000000000000000000000000000000000000000000000000000000000000"}`,
+		`{"type":"dropped","hash":"0x0400000000000000000000000000000000000000000000000000000000000000","from":"0x0000000000000000000000000000000000000b0b","nonce":"0x1","reason":"accountLimit"}`,
+		`{"type":"included","hash":"0x0100000000000000000000000000000000000000000000000000000000000000","from":"0x00000000000000000000000000000000000a11ce","nonce":"0x0","blockHash":"0x0500000000000000000000000000000000000000000000000000000000000000","blockNumber":"0x7"}`,
+	}
+	for i, ev := range events {
+		have, err := json.Marshal(newRPCTxPoolEvent(ev))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(have) != want[i] {
+			t.Errorf("event %d encoding mismatch:\nhave %s\nwant %s", i, have, want[i])
+		}
+	}
+}
+
+// Tests that a subscriber of transaction pool events which doesn't keep up has
+// its events dropped instead of stalling the delivery of other events.
+func TestTxPoolEventsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(db, Config{})
+		api          = NewFilterAPI(sys)
+		slow         = make(chan []txpool.TxEvent)
+		headers      = make(chan *types.Header)
+	)
+	slowSub := api.events.SubscribeTxPoolEvents(nil, nil, slow)
+	defer slowSub.Unsubscribe()
+	headSub := api.events.SubscribeNewHeads(headers)
+	defer headSub.Unsubscribe()
+
+	events := []txpool.TxEvent{{Type: txpool.TxEventAdded, Hash: common.Hash{1}}}
+	for i := 0; i < 2*txEventsQueueSize; i++ {
+		backend.txEventFeed.Send(events)
+	}
+	backend.chainFeed.Send(core.ChainEvent{Header: &types.Header{Number: big.NewInt(1)}})
+
+	select {
+	case header := <-headers:
+		if header.Number.Uint64() != 1 {
+			t.Fatalf("wrong header delivered: %d", header.Number)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("head event stalled by slow transaction pool events subscriber")
+	}
+	// The queued events are still delivered to the slow subscriber.
+	select {
+	case have := <-slow:
+		if !reflect.DeepEqual(have, events) {
+			t.Fatalf("event mismatch: have %v, want %v", have, events)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for events")
+	}
+}
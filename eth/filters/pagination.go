@@ -0,0 +1,176 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// defaultLogPageSize is the number of logs returned per page if the query
+	// has a cursor but no limit.
+	defaultLogPageSize = 1000
+
+	// maxLogPageSize is the maximum number of logs a single page may contain.
+	maxLogPageSize = 10000
+
+	// logPageSearchRange is the number of blocks searched at once while filling
+	// a page, if the node has no range limit configured, or while streaming the
+	// logs of a large range.
+	logPageSearchRange = 10000
+)
+
+var (
+	errInvalidCursor           = invalidParamsErr("invalid log cursor")
+	errCursorReorged           = errors.New("log cursor invalidated by chain reorg")
+	errLogPageTooLarge         = invalidParamsErr("log page limit exceeds maximum of %d", maxLogPageSize)
+	errPaginationWithBlockHash = invalidParamsErr("pagination is not supported for blockHash queries")
+	errPaginationUnsupported   = invalidParamsErr("cursor and limit are only supported by eth_getLogsPage")
+)
+
+// LogPage is the result of a paginated eth_getLogs query.
+type LogPage struct {
+	Logs []*types.Log `json:"logs"`
+
+	// Cursor continues the query with the next page, it is empty if the queried
+	// range has been searched completely.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// logCursor is the position of the first log not yet returned by a paginated
+// query. The hash of the block guards against continuing a query across a reorg.
+type logCursor struct {
+	number uint64
+	hash   common.Hash
+	index  uint
+}
+
+// String encodes the cursor into an opaque token.
+func (c *logCursor) String() string {
+	enc := make([]byte, 8+common.HashLength+4)
+	binary.BigEndian.PutUint64(enc, c.number)
+	copy(enc[8:], c.hash[:])
+	binary.BigEndian.PutUint32(enc[8+common.HashLength:], uint32(c.index))
+	return hexutil.Encode(enc)
+}
+
+// parseLogCursor decodes a cursor token.
+func parseLogCursor(token string) (*logCursor, error) {
+	enc, err := hexutil.Decode(token)
+	if err != nil || len(enc) != 8+common.HashLength+4 {
+		return nil, errInvalidCursor
+	}
+	return &logCursor{
+		number: binary.BigEndian.Uint64(enc),
+		hash:   common.BytesToHash(enc[8 : 8+common.HashLength]),
+		index:  uint(binary.BigEndian.Uint32(enc[8+common.HashLength:])),
+	}, nil
+}
+
+// logsPage returns at most limit logs matching the filter criteria, continuing
+// the search at the given cursor if it is non-nil. The returned cursor points
+// at the first log not included in the page, it is nil if the searched range
+// has been exhausted.
+//
+// The range is searched in sections using the log index, so a continued query
+// doesn't scan the blocks before the cursor again. If the filter has a range
+// limit, a single page searches at most that many blocks instead of failing.
+func (f *Filter) logsPage(ctx context.Context, cursor *logCursor, limit int) ([]*types.Log, *logCursor, error) {
+	if f.block != nil {
+		return nil, nil, errPaginationWithBlockHash
+	}
+	if f.begin == rpc.PendingBlockNumber.Int64() || f.end == rpc.PendingBlockNumber.Int64() {
+		return nil, nil, errPendingLogsUnsupported
+	}
+	begin, err := f.resolveSpecial(ctx, f.begin)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := f.resolveSpecial(ctx, f.end)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Pin the queried range to the current head, the cursor refers to it.
+	view := f.sys.backend.CurrentView()
+	if view == nil {
+		return nil, nil, errors.New("head block not available")
+	}
+	if begin == math.MaxUint64 {
+		begin = view.HeadNumber()
+	}
+	if end == math.MaxUint64 {
+		end = view.HeadNumber()
+	}
+	if begin > end {
+		return nil, nil, errInvalidBlockRange
+	}
+	if cursor != nil {
+		if cursor.number < begin || cursor.number > end {
+			return nil, nil, errInvalidCursor
+		}
+		if cursor.number > view.HeadNumber() || view.BlockHash(cursor.number) != cursor.hash {
+			return nil, nil, errCursorReorged
+		}
+		begin = cursor.number
+	}
+	span := uint64(logPageSearchRange)
+	if f.rangeLimit != 0 {
+		span = f.rangeLimit + 1
+	}
+	var logs []*types.Log
+	for from := begin; ; {
+		to := end
+		if end-from >= span {
+			to = from + span - 1
+		}
+		found, err := f.rangeLogs(ctx, from, to)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, log := range found {
+			if cursor != nil && log.BlockNumber == cursor.number && log.Index < cursor.index {
+				continue
+			}
+			if len(logs) == limit {
+				return logs, &logCursor{number: log.BlockNumber, hash: log.BlockHash, index: log.Index}, nil
+			}
+			logs = append(logs, log)
+		}
+		if to == end {
+			return logs, nil, nil
+		}
+		from = to + 1
+		if len(logs) == limit || f.rangeLimit != 0 {
+			// Continue with the next unsearched block. The current view may be
+			// outdated by now, resolve the hash of the block for the cursor from
+			// the latest one.
+			view = f.sys.backend.CurrentView()
+			if view == nil || from > view.HeadNumber() {
+				return nil, nil, errCursorReorged
+			}
+			return logs, &logCursor{number: from, hash: view.BlockHash(from)}, nil
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/filtermaps"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+func TestLogsPagination(t *testing.T) {
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(db, Config{})
+		addr         = common.BytesToAddress([]byte("logger"))
+		gspec        = &core.Genesis{
+			Config:  params.TestChainConfig,
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+	)
+	defer db.Close()
+
+	// Every tenth block contains three logs of the queried address.
+	_, chain, receipts := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), 100, func(i int, gen *core.BlockGen) {
+		if i%10 == 3 {
+			receipt := types.NewReceipt(nil, false, 0)
+			receipt.Logs = []*types.Log{{Address: addr}, {Address: addr}, {Address: addr}}
+			receipt.Bloom = types.CreateBloom(receipt)
+			gen.AddUncheckedReceipt(receipt)
+			gen.AddUncheckedTx(types.NewTransaction(999, common.HexToAddress("0x999"), big.NewInt(999), 999, gen.BaseFee(), nil))
+		}
+	})
+	gspec.MustCommit(db, triedb.NewDatabase(db, triedb.HashDefaults))
+	for i, block := range chain {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadBlockHash(db, block.Hash())
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+	}
+	backend.startFilterMaps(0, false, filtermaps.DefaultParams)
+	defer backend.stopFilterMaps()
+
+	all, err := sys.NewRangeFilter(0, int64(rpc.LatestBlockNumber), []common.Address{addr}, nil, 0).Logs(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 30 {
+		t.Fatalf("wrong number of logs: %d", len(all))
+	}
+
+	// Page through the logs with the API, four logs at a time.
+	var (
+		api    = NewFilterAPI(sys)
+		logs   []*types.Log
+		cursor string
+		pages  int
+	)
+	for {
+		var crit FilterCriteria
+		query := `{"fromBlock":"0x0","address":"` + addr.Hex() + `","limit":"0x4","cursor":"` + cursor + `"}`
+		if err := json.Unmarshal([]byte(query), &crit); err != nil {
+			t.Fatal(err)
+		}
+		page, err := api.GetLogsPage(context.Background(), crit)
+		if err != nil {
+			t.Fatalf("page %d: %v", pages, err)
+		}
+		if len(page.Logs) > 4 {
+			t.Fatalf("page %d: too many logs: %d", pages, len(page.Logs))
+		}
+		logs = append(logs, page.Logs...)
+		pages++
+		if cursor = page.Cursor; cursor == "" {
+			break
+		}
+	}
+	if pages != 8 {
+		t.Errorf("wrong number of pages: %d", pages)
+	}
+	if !reflect.DeepEqual(logs, all) {
+		t.Fatal("paginated logs mismatch")
+	}
+
+	// With a range limit, pages are cut short instead of failing the query.
+	filter := sys.NewRangeFilter(0, int64(rpc.LatestBlockNumber), []common.Address{addr}, nil, 14)
+	logs, pages = nil, 0
+	var next *logCursor
+	for {
+		page, cur, err := filter.logsPage(context.Background(), next, 1000)
+		if err != nil {
+			t.Fatalf("page %d: %v", pages, err)
+		}
+		if len(page) > 6 {
+			t.Fatalf("page %d: too many logs for searched range: %d", pages, len(page))
+		}
+		logs = append(logs, page...)
+		pages++
+		if next = cur; next == nil {
+			break
+		}
+	}
+	if pages != 7 {
+		t.Errorf("wrong number of pages: %d", pages)
+	}
+	if !reflect.DeepEqual(logs, all) {
+		t.Fatal("range limited logs mismatch")
+	}
+
+	// Invalid and stale cursors are rejected.
+	if _, _, err := filter.logsPage(context.Background(), &logCursor{number: 200}, 10); err != errInvalidCursor {
+		t.Errorf("expected invalid cursor error, got %v", err)
+	}
+	if _, _, err := filter.logsPage(context.Background(), &logCursor{number: 13, hash: common.Hash{1}}, 10); err != errCursorReorged {
+		t.Errorf("expected reorg error, got %v", err)
+	}
+	if _, err := parseLogCursor("0x1234"); err != errInvalidCursor {
+		t.Errorf("expected invalid cursor error, got %v", err)
+	}
+}
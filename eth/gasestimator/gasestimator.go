@@ -210,6 +210,51 @@ func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uin
 	return hi, nil, nil
 }
 
+// EstimateSequence returns the lowest possible gas limits allowing a sequence of
+// dependent transactions to run successfully. Each transaction is estimated on
+// top of the state produced by the previous ones, executed with their estimated
+// gas limits. The pre-state in the options is not modified.
+//
+// The gas cap, if non-zero, is a budget shared by the whole sequence: every
+// transaction is capped at the gas left over by the estimates before it.
+//
+// If any of the transactions fails, the index of it is returned along with the
+// revert data and the error.
+func EstimateSequence(ctx context.Context, calls []*core.Message, opts *Options, gasCap uint64) ([]uint64, int, []byte, error) {
+	seqOpts := *opts
+	seqOpts.State = opts.State.Copy()
+
+	var (
+		estimates = make([]uint64, len(calls))
+		budget    = gasCap
+	)
+	for i, call := range calls {
+		if gasCap != 0 && budget == 0 {
+			return nil, i, nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+		}
+		estimate, revert, err := Estimate(ctx, call, &seqOpts, budget)
+		if err != nil {
+			return nil, i, revert, err
+		}
+		estimates[i] = estimate
+		if gasCap != 0 {
+			budget -= estimate
+		}
+
+		// Apply the transaction with the estimated gas limit for the next ones
+		call.GasLimit = estimate
+		result, err := apply(ctx, call, &seqOpts, seqOpts.State)
+		if err != nil {
+			return nil, i, nil, err
+		}
+		if result.Failed() {
+			return nil, i, result.Revert(), result.Err
+		}
+		seqOpts.State.Finalise(true)
+	}
+	return estimates, 0, nil, nil
+}
+
 // execute is a helper that executes the transaction under a given gas limit and
 // returns true if the transaction fails for a reason that might be related to
 // not enough gas. A non-nil error means execution failed due to reasons unrelated
@@ -237,11 +282,14 @@ func execute(ctx context.Context, call *core.Message, opts *Options, gasLimit ui
 // run assembles the EVM as defined by the consensus rules and runs the requested
 // call invocation.
 func run(ctx context.Context, call *core.Message, opts *Options) (*core.ExecutionResult, error) {
+	return apply(ctx, call, opts, opts.State.Copy())
+}
+
+// apply runs the requested call invocation on top of the given state, leaving
+// the state changes in place.
+func apply(ctx context.Context, call *core.Message, opts *Options, dirtyState *state.StateDB) (*core.ExecutionResult, error) {
 	// Assemble the call and the call context
-	var (
-		evmContext = core.NewEVMBlockContext(opts.Header, opts.Chain, nil)
-		dirtyState = opts.State.Copy()
-	)
+	evmContext := core.NewEVMBlockContext(opts.Header, opts.Chain, nil)
 	if opts.BlockOverrides != nil {
 		if err := opts.BlockOverrides.Apply(&evmContext); err != nil {
 			return nil, err
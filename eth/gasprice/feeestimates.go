@@ -0,0 +1,306 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// feeEstimateBlocks is the number of recent blocks the fee estimates are
+	// derived from and backtested against.
+	feeEstimateBlocks = 20
+
+	// fullBlockRatio is the gas used ratio above which a block is considered
+	// full, only admitting transactions outbidding the included ones.
+	fullBlockRatio = 0.9
+
+	// feeEstimateBlockTime is the assumed time between the projected blocks.
+	feeEstimateBlockTime = 12
+)
+
+// feeEstimateTargets are the confirmation targets of the fee estimates, along
+// with the percentile of the recently paid tips the suggestions start from.
+var feeEstimateTargets = []struct {
+	blocks     uint64
+	percentile float64
+}{
+	{blocks: 1, percentile: 60},
+	{blocks: 3, percentile: 40},
+	{blocks: 10, percentile: 20},
+}
+
+// FeeEstimate is the suggested fee for a transaction to be included within a
+// number of blocks.
+type FeeEstimate struct {
+	Blocks      uint64   // Number of blocks the transaction is expected to be included within
+	TipCap      *big.Int // Suggested tip cap
+	BaseFee     *big.Int // Highest base fee projected within the target, nil before London
+	BlobBaseFee *big.Int // Highest blob base fee projected within the target, nil before Cancun
+
+	// Confidence is the share of the recent block ranges of the target length in
+	// which a transaction paying the suggested tip would have been included.
+	Confidence float64
+}
+
+// pendingTx is the gas demand of a pending transaction at the next base fee.
+type pendingTx struct {
+	tip     *big.Int
+	gas     uint64
+	blobGas uint64
+}
+
+// FeeEstimates returns fee suggestions for several confirmation targets. Unlike
+// SuggestTipCap, the estimates don't only rely on the tips paid in recent blocks
+// but also consider the backlog of pending transactions, reacting to sudden
+// congestion before it shows up on chain. The base fees are projected along the
+// backlog and the recent block utilization.
+//
+// The estimates are cached until the next head block, the pool is only sampled
+// once per block.
+func (oracle *Oracle) FeeEstimates(ctx context.Context) ([]FeeEstimate, error) {
+	head, err := oracle.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	headHash := head.Hash()
+
+	// If the estimates of the latest block are still available, return them.
+	oracle.cacheLock.RLock()
+	lastHead, lastEstimates := oracle.lastEstimatesHead, oracle.lastEstimates
+	oracle.cacheLock.RUnlock()
+	if headHash == lastHead {
+		return copyEstimates(lastEstimates), nil
+	}
+	// Retrieve the lowest and the sampled tips of the recent blocks. The
+	// percentiles are requested in ascending order, following the minimum.
+	percentiles := []float64{0}
+	for i := len(feeEstimateTargets) - 1; i >= 0; i-- {
+		percentiles = append(percentiles, feeEstimateTargets[i].percentile)
+	}
+	_, rewards, _, gasUsedRatios, _, blobGasUsedRatios, err := oracle.FeeHistory(ctx, feeEstimateBlocks, rpc.BlockNumber(head.Number.Int64()), percentiles)
+	if err != nil {
+		return nil, err
+	}
+	var avgGasRatio, avgBlobRatio float64
+	for i := range gasUsedRatios {
+		avgGasRatio += gasUsedRatios[i] / float64(len(gasUsedRatios))
+		avgBlobRatio += blobGasUsedRatios[i] / float64(len(blobGasUsedRatios))
+	}
+	// Project the base fees of the upcoming blocks, filling them with the
+	// pending transactions first and the recent utilization afterwards.
+	var (
+		config     = oracle.backend.ChainConfig()
+		maxBlocks  = feeEstimateTargets[len(feeEstimateTargets)-1].blocks
+		nextHeader = oracle.projectHeader(head)
+	)
+	pending := oracle.pendingTxs(nextHeader)
+
+	var backlog, blobBacklog uint64
+	for _, tx := range pending {
+		backlog += tx.gas
+		blobBacklog += tx.blobGas
+	}
+	var (
+		baseFees = make([]*big.Int, 0, maxBlocks)
+		blobFees = make([]*big.Int, 0, maxBlocks)
+		header   = nextHeader
+	)
+	for len(baseFees) < int(maxBlocks) {
+		baseFees = append(baseFees, header.BaseFee)
+		if header.ExcessBlobGas != nil {
+			blobFees = append(blobFees, eip4844.CalcBlobFee(config, header))
+		} else {
+			blobFees = append(blobFees, nil)
+		}
+		gasUsed := max(min(backlog, header.GasLimit), uint64(avgGasRatio*float64(header.GasLimit)))
+		backlog -= min(backlog, header.GasLimit)
+
+		maxBlobGas := eip4844.MaxBlobGasPerBlock(config, header.Time)
+		blobGasUsed := max(min(blobBacklog, maxBlobGas), uint64(avgBlobRatio*float64(maxBlobGas)))
+		blobBacklog -= min(blobBacklog, maxBlobGas)
+
+		header.GasUsed = gasUsed
+		if header.BlobGasUsed != nil {
+			header.BlobGasUsed = &blobGasUsed
+		}
+		header = oracle.projectHeader(header)
+	}
+	// Assemble the estimates of the individual targets
+	estimates := make([]FeeEstimate, 0, len(feeEstimateTargets))
+	for i, target := range feeEstimateTargets {
+		var samples []*big.Int
+		for j, reward := range rewards {
+			if gasUsedRatios[j] > 0 {
+				samples = append(samples, reward[len(feeEstimateTargets)-i])
+			}
+		}
+		var tip *big.Int
+		if len(samples) > 0 {
+			slices.SortFunc(samples, func(a, b *big.Int) int { return a.Cmp(b) })
+			tip = new(big.Int).Set(samples[len(samples)/2])
+		} else if tip, err = oracle.SuggestTipCap(ctx); err != nil {
+			return nil, err
+		}
+		// Outbid the pending transactions not fitting into the target
+		var gas uint64
+		for _, tx := range pending {
+			if gas += tx.gas; gas > target.blocks*nextHeader.GasLimit {
+				if tx.tip.Cmp(tip) >= 0 {
+					tip = new(big.Int).Add(tx.tip, big.NewInt(1))
+				}
+				break
+			}
+		}
+		if tip.Cmp(oracle.maxPrice) > 0 {
+			tip = new(big.Int).Set(oracle.maxPrice)
+		}
+		estimate := FeeEstimate{
+			Blocks:     target.blocks,
+			TipCap:     tip,
+			Confidence: backtestTip(tip, target.blocks, rewards, gasUsedRatios),
+		}
+		for j := uint64(0); j < target.blocks; j++ {
+			if fee := baseFees[j]; fee != nil && (estimate.BaseFee == nil || fee.Cmp(estimate.BaseFee) > 0) {
+				estimate.BaseFee = fee
+			}
+			if fee := blobFees[j]; fee != nil && (estimate.BlobBaseFee == nil || fee.Cmp(estimate.BlobBaseFee) > 0) {
+				estimate.BlobBaseFee = fee
+			}
+		}
+		estimates = append(estimates, estimate)
+	}
+	oracle.cacheLock.Lock()
+	oracle.lastEstimatesHead = headHash
+	oracle.lastEstimates = estimates
+	oracle.cacheLock.Unlock()
+
+	return copyEstimates(estimates), nil
+}
+
+// copyEstimates returns a deep copy of the given fee estimates, so the cached
+// ones can't be modified by the callers.
+func copyEstimates(estimates []FeeEstimate) []FeeEstimate {
+	copied := make([]FeeEstimate, len(estimates))
+	for i, estimate := range estimates {
+		copied[i] = estimate
+		copied[i].TipCap = new(big.Int).Set(estimate.TipCap)
+		if estimate.BaseFee != nil {
+			copied[i].BaseFee = new(big.Int).Set(estimate.BaseFee)
+		}
+		if estimate.BlobBaseFee != nil {
+			copied[i].BlobBaseFee = new(big.Int).Set(estimate.BlobBaseFee)
+		}
+	}
+	return copied
+}
+
+// projectHeader creates the header of a hypothetical child block, carrying the
+// fields the base fees are derived from.
+func (oracle *Oracle) projectHeader(parent *types.Header) *types.Header {
+	config := oracle.backend.ChainConfig()
+	header := &types.Header{
+		Number:   new(big.Int).Add(parent.Number, big.NewInt(1)),
+		Time:     parent.Time + feeEstimateBlockTime,
+		GasLimit: parent.GasLimit,
+	}
+	if config.IsLondon(header.Number) {
+		header.BaseFee = eip1559.CalcBaseFee(config, parent)
+	}
+	if config.IsCancun(header.Number, header.Time) {
+		excess := eip4844.CalcExcessBlobGas(config, parent, header.Time)
+		header.ExcessBlobGas, header.BlobGasUsed = &excess, new(uint64)
+	}
+	return header
+}
+
+// pendingTxs returns the pending transactions able to pay the base fee of the
+// given header, ordered by their effective tip in descending order. Only the fee
+// and gas fields of the pool's lazy transactions are used, the transactions are
+// never resolved.
+func (oracle *Oracle) pendingTxs(header *types.Header) []pendingTx {
+	var baseFee *uint256.Int
+	if header.BaseFee != nil {
+		baseFee = uint256.MustFromBig(header.BaseFee)
+	}
+	filter := txpool.PendingFilter{BlobTxs: false}
+	plainTxs := oracle.backend.TxPoolPending(filter)
+
+	filter.BlobTxs = true
+	if oracle.backend.ChainConfig().IsOsaka(header.Number, header.Time) {
+		filter.BlobVersion = types.BlobSidecarVersion1
+	} else {
+		filter.BlobVersion = types.BlobSidecarVersion0
+	}
+	blobTxs := oracle.backend.TxPoolPending(filter)
+
+	var pending []pendingTx
+	for _, set := range []map[common.Address][]*txpool.LazyTransaction{plainTxs, blobTxs} {
+		for _, txs := range set {
+			for _, tx := range txs {
+				tip := tx.GasTipCap
+				if baseFee != nil {
+					if tx.GasFeeCap.Lt(baseFee) {
+						continue
+					}
+					tip = new(uint256.Int).Sub(tx.GasFeeCap, baseFee)
+					if tip.Gt(tx.GasTipCap) {
+						tip = tx.GasTipCap
+					}
+				}
+				if tip.ToBig().Cmp(oracle.ignorePrice) < 0 {
+					continue
+				}
+				pending = append(pending, pendingTx{tip: tip.ToBig(), gas: tx.Gas, blobGas: tx.BlobGas})
+			}
+		}
+	}
+	slices.SortStableFunc(pending, func(a, b pendingTx) int { return b.tip.Cmp(a.tip) })
+	return pending
+}
+
+// backtestTip returns the share of the recent block ranges of the given length
+// in which at least one block would have admitted a transaction paying the tip.
+// A block admits the transaction if it wasn't full or if the tip exceeds the
+// lowest one included.
+func backtestTip(tip *big.Int, blocks uint64, rewards [][]*big.Int, gasUsedRatios []float64) float64 {
+	if len(rewards) == 0 {
+		return 0
+	}
+	windows := max(len(rewards)-int(blocks)+1, 1)
+
+	var admitted int
+	for i := 0; i < windows; i++ {
+		for j := i; j < len(rewards) && j < i+int(blocks); j++ {
+			if gasUsedRatios[j] < fullBlockRatio || tip.Cmp(rewards[j][0]) >= 0 {
+				admitted++
+				break
+			}
+		}
+	}
+	return float64(admitted) / float64(windows)
+}
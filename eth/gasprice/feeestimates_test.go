@@ -0,0 +1,112 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestFeeEstimates(t *testing.T) {
+	config := Config{
+		MaxHeaderHistory: 1000,
+		MaxBlockHistory:  1000,
+	}
+	backend := newTestBackend(t, big.NewInt(16), big.NewInt(28), false)
+	defer backend.teardown()
+	oracle := NewOracle(backend, config, nil)
+
+	// Without pending transactions, the tips are derived from the recent blocks,
+	// each of which pays a single tip of (number) gwei.
+	estimates, err := oracle.FeeEstimates(context.Background())
+	if err != nil {
+		t.Fatalf("failed to retrieve fee estimates: %v", err)
+	}
+	if len(estimates) != len(feeEstimateTargets) {
+		t.Fatalf("wrong number of estimates: have %d, want %d", len(estimates), len(feeEstimateTargets))
+	}
+	head, _ := backend.HeaderByNumber(context.Background(), rpc.LatestBlockNumber)
+	next := oracle.projectHeader(head)
+	for i, estimate := range estimates {
+		if estimate.Blocks != feeEstimateTargets[i].blocks {
+			t.Errorf("estimate %d: target mismatch: have %d, want %d", i, estimate.Blocks, feeEstimateTargets[i].blocks)
+		}
+		if estimate.TipCap.Cmp(big.NewInt(23*params.GWei)) != 0 {
+			t.Errorf("estimate %d: tip mismatch: have %v, want %v", i, estimate.TipCap, 23*params.GWei)
+		}
+		if estimate.Confidence != 1 {
+			t.Errorf("estimate %d: confidence mismatch: have %v, want 1", i, estimate.Confidence)
+		}
+		if estimate.BaseFee == nil || estimate.BaseFee.Cmp(next.BaseFee) < 0 {
+			t.Errorf("estimate %d: base fee below the next one: have %v, want >= %v", i, estimate.BaseFee, next.BaseFee)
+		}
+		if estimate.BlobBaseFee == nil {
+			t.Errorf("estimate %d: missing blob base fee", i)
+		}
+	}
+
+	// Congest the pool with one and a half blocks worth of highly paying
+	// transactions. The next block target has to outbid them, the later ones
+	// only anticipate the rising base fee.
+	var (
+		key, _ = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+		signer = types.LatestSigner(backend.ChainConfig())
+		tip    = big.NewInt(100 * params.GWei)
+	)
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		backend.pool = append(backend.pool, types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   backend.ChainConfig().ChainID,
+			Nonce:     nonce,
+			To:        &common.Address{},
+			Gas:       head.GasLimit / 2,
+			GasFeeCap: big.NewInt(1000 * params.GWei),
+			GasTipCap: tip,
+		}))
+	}
+	// The estimates are cached until the next head block.
+	cached, err := oracle.FeeEstimates(context.Background())
+	if err != nil {
+		t.Fatalf("failed to retrieve fee estimates: %v", err)
+	}
+	if !reflect.DeepEqual(cached, estimates) {
+		t.Errorf("cached estimates mismatch:\nhave %+v\nwant %+v", cached, estimates)
+	}
+	estimates, err = NewOracle(backend, config, nil).FeeEstimates(context.Background())
+	if err != nil {
+		t.Fatalf("failed to retrieve fee estimates: %v", err)
+	}
+	if want := new(big.Int).Add(tip, big.NewInt(1)); estimates[0].TipCap.Cmp(want) != 0 {
+		t.Errorf("next block tip mismatch: have %v, want %v", estimates[0].TipCap, want)
+	}
+	if estimates[1].TipCap.Cmp(big.NewInt(23*params.GWei)) != 0 {
+		t.Errorf("later block tip mismatch: have %v, want %v", estimates[1].TipCap, 23*params.GWei)
+	}
+	if estimates[0].BaseFee.Cmp(next.BaseFee) != 0 {
+		t.Errorf("next block base fee mismatch: have %v, want %v", estimates[0].BaseFee, next.BaseFee)
+	}
+	if want := new(big.Int).Div(new(big.Int).Mul(next.BaseFee, big.NewInt(9)), big.NewInt(8)); estimates[1].BaseFee.Cmp(want) < 0 {
+		t.Errorf("base fee not rising after full block: have %v, want >= %v", estimates[1].BaseFee, want)
+	}
+}
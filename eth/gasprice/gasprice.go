@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -56,6 +57,7 @@ type OracleBackend interface {
 	BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error)
 	GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error)
 	Pending() (*types.Block, types.Receipts, *state.StateDB)
+	TxPoolPending(filter txpool.PendingFilter) map[common.Address][]*txpool.LazyTransaction
 	ChainConfig() *params.ChainConfig
 	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
 }
@@ -71,6 +73,9 @@ type Oracle struct {
 	cacheLock   sync.RWMutex
 	fetchLock   sync.Mutex
 
+	lastEstimatesHead common.Hash   // Head block the cached fee estimates were derived at
+	lastEstimates     []FeeEstimate // Fee estimates cached for the head block
+
 	checkBlocks, percentile           int
 	maxHeaderHistory, maxBlockHistory uint64
 
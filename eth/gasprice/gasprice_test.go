@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
@@ -42,7 +43,8 @@ const testHead = 32
 
 type testBackend struct {
 	chain   *core.BlockChain
-	pending bool // pending block available
+	pending bool               // pending block available
+	pool    types.Transactions // pending transactions in the pool
 }
 
 func (b *testBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
@@ -110,6 +112,27 @@ func (b *testBackend) Pending() (*types.Block, types.Receipts, *state.StateDB) {
 	return nil, nil, nil
 }
 
+func (b *testBackend) TxPoolPending(filter txpool.PendingFilter) map[common.Address][]*txpool.LazyTransaction {
+	signer := types.LatestSigner(b.chain.Config())
+	pending := make(map[common.Address][]*txpool.LazyTransaction)
+	for _, tx := range b.pool {
+		if (tx.Type() == types.BlobTxType) != filter.BlobTxs {
+			continue
+		}
+		from, _ := types.Sender(signer, tx)
+		pending[from] = append(pending[from], &txpool.LazyTransaction{
+			Hash:      tx.Hash(),
+			Tx:        tx,
+			Time:      tx.Time(),
+			GasFeeCap: uint256.MustFromBig(tx.GasFeeCap()),
+			GasTipCap: uint256.MustFromBig(tx.GasTipCap()),
+			Gas:       tx.Gas(),
+			BlobGas:   tx.BlobGas(),
+		})
+	}
+	return pending
+}
+
 func (b *testBackend) ChainConfig() *params.ChainConfig {
 	return b.chain.Config()
 }
@@ -95,6 +95,10 @@ type txPool interface {
 
 	// FilterType returns whether the given tx type is supported by the txPool.
 	FilterType(kind byte) bool
+
+	// IsPrivate returns whether the transaction was submitted privately and
+	// must not be propagated to the network.
+	IsPrivate(hash common.Hash) bool
 }
 
 // handlerConfig is the collection of initialization parameters to create a full
@@ -474,6 +478,9 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 	)
 
 	for _, tx := range txs {
+		if h.txpool.IsPrivate(tx.Hash()) {
+			continue
+		}
 		var directSet map[*ethPeer]struct{}
 		switch {
 		case tx.Type() == types.BlobTxType:
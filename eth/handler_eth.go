@@ -20,7 +20,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -31,7 +33,34 @@ import (
 type ethHandler handler
 
 func (h *ethHandler) Chain() *core.BlockChain { return h.chain }
-func (h *ethHandler) TxPool() eth.TxPool      { return h.txpool }
+func (h *ethHandler) TxPool() eth.TxPool      { return publicTxPool{h.txpool} }
+
+// publicTxPool is the view of the transaction pool exposed to remote peers,
+// hiding any privately submitted transactions.
+type publicTxPool struct {
+	pool txPool
+}
+
+func (p publicTxPool) Get(hash common.Hash) *types.Transaction {
+	if p.pool.IsPrivate(hash) {
+		return nil
+	}
+	return p.pool.Get(hash)
+}
+
+func (p publicTxPool) GetRLP(hash common.Hash) []byte {
+	if p.pool.IsPrivate(hash) {
+		return nil
+	}
+	return p.pool.GetRLP(hash)
+}
+
+func (p publicTxPool) GetMetadata(hash common.Hash) *txpool.TxMetadata {
+	if p.pool.IsPrivate(hash) {
+		return nil
+	}
+	return p.pool.GetMetadata(hash)
+}
 
 // RunPeer is invoked when a peer joins on the `eth` protocol.
 func (h *ethHandler) RunPeer(peer *eth.Peer, hand eth.Handler) error {
@@ -423,3 +423,74 @@ func testTransactionPropagation(t *testing.T, protocol uint) {
 		}
 	}
 }
+
+// Tests that privately submitted transactions are neither broadcast nor announced
+// to peers, while public ones are propagated as usual.
+func TestPrivateTransactionIsolation68(t *testing.T) { testPrivateTransactionIsolation(t, eth.ETH68) }
+
+func testPrivateTransactionIsolation(t *testing.T, protocol uint) {
+	t.Parallel()
+
+	// Create a source handler holding a private transaction already and a sink
+	// to receive the propagated transactions
+	source := newTestHandler(ethconfig.FullSync)
+	defer source.close()
+
+	sink := newTestHandler(ethconfig.FullSync)
+	defer sink.close()
+	sink.handler.synced.Store(true) // mark synced to accept transactions
+
+	sign := func(nonce uint64) *types.Transaction {
+		tx := types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil)
+		tx, _ = types.SignTx(tx, types.HomesteadSigner{}, testKey)
+		return tx
+	}
+	synced, private, public := sign(0), sign(1), sign(2)
+	source.txpool.addPrivate([]*types.Transaction{synced})
+
+	sourcePipe, sinkPipe := p2p.MsgPipe()
+	defer sourcePipe.Close()
+	defer sinkPipe.Close()
+
+	sourcePeer := eth.NewPeer(protocol, p2p.NewPeerPipe(enode.ID{1}, "", nil, sourcePipe), sourcePipe, source.txpool)
+	sinkPeer := eth.NewPeer(protocol, p2p.NewPeerPipe(enode.ID{0}, "", nil, sinkPipe), sinkPipe, sink.txpool)
+	defer sourcePeer.Close()
+	defer sinkPeer.Close()
+
+	go source.handler.runEthPeer(sourcePeer, func(peer *eth.Peer) error {
+		return eth.Handle((*ethHandler)(source.handler), peer)
+	})
+	go sink.handler.runEthPeer(sinkPeer, func(peer *eth.Peer) error {
+		return eth.Handle((*ethHandler)(sink.handler), peer)
+	})
+	txCh := make(chan core.NewTxsEvent, 16)
+	sub := sink.txpool.SubscribeTransactions(txCh, false)
+	defer sub.Unsubscribe()
+
+	// Insert a private and a public transaction, only the latter should arrive
+	source.txpool.addPrivate([]*types.Transaction{private})
+	source.txpool.Add([]*types.Transaction{public}, false)
+
+	timeout := time.After(2 * time.Second)
+	for !sink.txpool.Has(public.Hash()) {
+		select {
+		case event := <-txCh:
+			for _, tx := range event.Txs {
+				if tx.Hash() != public.Hash() {
+					t.Fatalf("private transaction propagated: %x", tx.Hash())
+				}
+			}
+		case <-timeout:
+			t.Fatal("public transaction propagation timed out")
+		}
+	}
+	// Private transactions must not be served on request either
+	if txs := (*ethHandler)(source.handler).TxPool().GetRLP(private.Hash()); txs != nil {
+		t.Errorf("private transaction served to peers")
+	}
+	select {
+	case event := <-txCh:
+		t.Errorf("unexpected transactions propagated: %v", event.Txs)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
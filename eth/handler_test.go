@@ -54,7 +54,8 @@ var (
 // Its goal is to get around setting up a valid statedb for the balance and nonce
 // checks.
 type testTxPool struct {
-	pool map[common.Hash]*types.Transaction // Hash map of collected transactions
+	pool    map[common.Hash]*types.Transaction // Hash map of collected transactions
+	private map[common.Hash]struct{}           // Set of transactions not to be propagated
 
 	txFeed event.Feed   // Notification feed to allow waiting for inclusion
 	lock   sync.RWMutex // Protects the transaction pool
@@ -63,7 +64,8 @@ type testTxPool struct {
 // newTestTxPool creates a mock transaction pool.
 func newTestTxPool() *testTxPool {
 	return &testTxPool{
-		pool: make(map[common.Hash]*types.Transaction),
+		pool:    make(map[common.Hash]*types.Transaction),
+		private: make(map[common.Hash]struct{}),
 	}
 }
 
@@ -127,6 +129,17 @@ func (p *testTxPool) Add(txs []*types.Transaction, sync bool) []error {
 	return make([]error, len(txs))
 }
 
+// addPrivate marks a batch of transactions private before adding them to the pool.
+func (p *testTxPool) addPrivate(txs []*types.Transaction) []error {
+	p.lock.Lock()
+	for _, tx := range txs {
+		p.private[tx.Hash()] = struct{}{}
+	}
+	p.lock.Unlock()
+
+	return p.Add(txs, false)
+}
+
 // Pending returns all the transactions known to the pool
 func (p *testTxPool) Pending(filter txpool.PendingFilter) map[common.Address][]*txpool.LazyTransaction {
 	p.lock.RLock()
@@ -163,6 +176,15 @@ func (p *testTxPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bo
 	return p.txFeed.Subscribe(ch)
 }
 
+// IsPrivate returns whether the transaction was submitted privately.
+func (p *testTxPool) IsPrivate(hash common.Hash) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	_, ok := p.private[hash]
+	return ok
+}
+
 // FilterType should check whether the pool supports the given type of transactions.
 func (p *testTxPool) FilterType(kind byte) bool {
 	switch kind {
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"math/big"
 	"os"
 	"runtime"
@@ -444,37 +445,37 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (api *API) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
+func (api *API) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
 	block, err := api.blockByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
-	return api.traceBlock(ctx, block, config)
+	return api.traceBlockStream(ctx, block, config)
 }
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (api *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
+func (api *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
 	block, err := api.blockByHash(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
-	return api.traceBlock(ctx, block, config)
+	return api.traceBlockStream(ctx, block, config)
 }
 
 // TraceBlock returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (api *API) TraceBlock(ctx context.Context, blob hexutil.Bytes, config *TraceConfig) ([]*txTraceResult, error) {
+func (api *API) TraceBlock(ctx context.Context, blob hexutil.Bytes, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
 	block := new(types.Block)
 	if err := rlp.DecodeBytes(blob, block); err != nil {
 		return nil, fmt.Errorf("could not decode block: %v", err)
 	}
-	return api.traceBlock(ctx, block, config)
+	return api.traceBlockStream(ctx, block, config)
 }
 
 // TraceBlockFromFile returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (api *API) TraceBlockFromFile(ctx context.Context, file string, config *TraceConfig) ([]*txTraceResult, error) {
+func (api *API) TraceBlockFromFile(ctx context.Context, file string, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
 	blob, err := os.ReadFile(file)
 	if err != nil {
 		return nil, fmt.Errorf("could not read file: %v", err)
@@ -485,12 +486,12 @@ func (api *API) TraceBlockFromFile(ctx context.Context, file string, config *Tra
 // TraceBadBlock returns the structured logs created during the execution of
 // EVM against a block pulled from the pool of bad ones and returns them as a JSON
 // object.
-func (api *API) TraceBadBlock(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
+func (api *API) TraceBadBlock(ctx context.Context, hash common.Hash, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
 	block := rawdb.ReadBadBlock(api.backend.ChainDb(), hash)
 	if block == nil {
 		return nil, fmt.Errorf("bad block %#x not found", hash)
 	}
-	return api.traceBlock(ctx, block, config)
+	return api.traceBlockStream(ctx, block, config)
 }
 
 // StandardTraceBlockToFile dumps the structured logs created during the
@@ -583,6 +584,29 @@ func (api *API) StandardTraceBadBlockToFile(ctx context.Context, hash common.Has
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
 func (api *API) traceBlock(ctx context.Context, block *types.Block, config *TraceConfig) ([]*txTraceResult, error) {
+	seq, err := api.traceBlockSeq(ctx, block, config)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.NewArrayStream(seq).Collect()
+}
+
+// traceBlockStream traces a block and streams the per-transaction results to
+// the client, tracing the transactions as the response is being written.
+func (api *API) traceBlockStream(ctx context.Context, block *types.Block, config *TraceConfig) (*rpc.ArrayStream[*txTraceResult], error) {
+	seq, err := api.traceBlockSeq(ctx, block, config)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.NewArrayStream(seq), nil
+}
+
+// traceBlockSeq configures a new tracer according to the provided configuration,
+// and returns an iterator executing the transactions contained within the block.
+// Transactions are traced one by one as the iterator is consumed, except for JS
+// tracers which trace the whole block concurrently. The state of the block is
+// only acquired once iteration starts and is released when it ends.
+func (api *API) traceBlockSeq(ctx context.Context, block *types.Block, config *TraceConfig) (iter.Seq2[*txTraceResult, error], error) {
 	if block.NumberU64() == 0 {
 		return nil, errors.New("genesis is not traceable")
 	}
@@ -595,52 +619,66 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	if config != nil && config.Reexec != nil {
 		reexec = *config.Reexec
 	}
-	statedb, release, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
-	if err != nil {
-		return nil, err
-	}
-	defer release()
-
-	blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	evm := vm.NewEVM(blockCtx, statedb, api.backend.ChainConfig(), vm.Config{})
-	if beaconRoot := block.BeaconRoot(); beaconRoot != nil {
-		core.ProcessBeaconBlockRoot(*beaconRoot, evm)
-	}
-	if api.backend.ChainConfig().IsPrague(block.Number(), block.Time()) {
-		core.ProcessParentBlockHash(block.ParentHash(), evm)
-	}
+	return func(yield func(*txTraceResult, error) bool) {
+		statedb, release, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
 
-	// JS tracers have high overhead. In this case run a parallel
-	// process that generates states in one thread and traces txes
-	// in separate worker threads.
-	if config != nil && config.Tracer != nil && *config.Tracer != "" {
-		if isJS := DefaultDirectory.IsJS(*config.Tracer); isJS {
-			return api.traceBlockParallel(ctx, block, statedb, config)
+		blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
+		evm := vm.NewEVM(blockCtx, statedb, api.backend.ChainConfig(), vm.Config{})
+		if beaconRoot := block.BeaconRoot(); beaconRoot != nil {
+			core.ProcessBeaconBlockRoot(*beaconRoot, evm)
 		}
-	}
-	// Native tracers have low overhead
-	var (
-		txs       = block.Transactions()
-		blockHash = block.Hash()
-		signer    = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
-		results   = make([]*txTraceResult, len(txs))
-	)
-	for i, tx := range txs {
-		// Generate the next state snapshot fast without tracing
-		msg, _ := core.TransactionToMessage(tx, signer, block.BaseFee())
-		txctx := &Context{
-			BlockHash:   blockHash,
-			BlockNumber: block.Number(),
-			TxIndex:     i,
-			TxHash:      tx.Hash(),
+		if api.backend.ChainConfig().IsPrague(block.Number(), block.Time()) {
+			core.ProcessParentBlockHash(block.ParentHash(), evm)
 		}
-		res, err := api.traceTx(ctx, tx, msg, txctx, blockCtx, statedb, config, nil)
-		if err != nil {
-			return nil, err
+
+		// JS tracers have high overhead. In this case run a parallel
+		// process that generates states in one thread and traces txes
+		// in separate worker threads.
+		if config != nil && config.Tracer != nil && *config.Tracer != "" {
+			if isJS := DefaultDirectory.IsJS(*config.Tracer); isJS {
+				results, err := api.traceBlockParallel(ctx, block, statedb, config)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				for _, res := range results {
+					if !yield(res, nil) {
+						return
+					}
+				}
+				return
+			}
 		}
-		results[i] = &txTraceResult{TxHash: tx.Hash(), Result: res}
-	}
-	return results, nil
+		// Native tracers have low overhead
+		var (
+			txs       = block.Transactions()
+			blockHash = block.Hash()
+			signer    = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
+		)
+		for i, tx := range txs {
+			// Generate the next state snapshot fast without tracing
+			msg, _ := core.TransactionToMessage(tx, signer, block.BaseFee())
+			txctx := &Context{
+				BlockHash:   blockHash,
+				BlockNumber: block.Number(),
+				TxIndex:     i,
+				TxHash:      tx.Hash(),
+			}
+			res, err := api.traceTx(ctx, tx, msg, txctx, blockCtx, statedb, config, nil)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(&txTraceResult{TxHash: tx.Hash(), Result: res}, nil) {
+				return
+			}
+		}
+	}, nil
 }
 
 // traceBlockParallel is for tracers that have a high overhead (read JS tracers). One thread
@@ -1070,6 +1108,10 @@ func APIs(backend Backend) []rpc.API {
 			Namespace: "debug",
 			Service:   NewAPI(backend),
 		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(backend),
+		},
 	}
 }
 
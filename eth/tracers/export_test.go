@@ -0,0 +1,32 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+// Exports of the internal tests for the external test package, which can import
+// the native tracers without an import cycle.
+
+const MaxTraceFilterRange = maxTraceFilterRange
+
+var (
+	ErrTraceFilterRange = errTraceFilterRange
+	NewTestBackend      = newTestBackend
+)
+
+// Teardown releases the resources of the test backend.
+func (b *testBackend) Teardown() {
+	b.teardown()
+}
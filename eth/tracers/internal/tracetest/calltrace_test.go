@@ -341,6 +341,20 @@ func TestInternals(t *testing.T) {
 			tracer: mkTracer("prestateTracer", nil),
 			want:   fmt.Sprintf(`{"0x00000000000000000000000000000000deadbeef":{"balance":"0x0","code":"0x6001600052600160ff60016000f560ff6000a0","codeHash":"0x5544040a7fd107ba8164108904724a38fb9c664daae88a5cc53580841e648edf"},"%s":{"balance":"0x1c6bf52634000"}}`, originHex),
 		},
+		{
+			name: "VM trace - memory and storage writes",
+			code: []byte{
+				byte(vm.PUSH1), 0x2a,
+				byte(vm.PUSH1), 0x0,
+				byte(vm.MSTORE8),
+				byte(vm.PUSH1), 0x2a,
+				byte(vm.PUSH1), 0x1,
+				byte(vm.SSTORE),
+				byte(vm.STOP),
+			},
+			tracer: mkTracer("vmTracer", nil),
+			want:   `{"code":"0x602a600053602a60015500","ops":[{"cost":3,"ex":{"mem":null,"push":["0x2a"],"store":null,"used":58997},"pc":0,"sub":null},{"cost":3,"ex":{"mem":null,"push":["0x0"],"store":null,"used":58994},"pc":2,"sub":null},{"cost":6,"ex":{"mem":{"data":"0x2a","off":0},"push":[],"store":null,"used":58988},"pc":4,"sub":null},{"cost":3,"ex":{"mem":null,"push":["0x2a"],"store":null,"used":58985},"pc":5,"sub":null},{"cost":3,"ex":{"mem":null,"push":["0x1"],"store":null,"used":58982},"pc":7,"sub":null},{"cost":20000,"ex":{"mem":null,"push":[],"store":{"key":"0x1","val":"0x2a"},"used":38982},"pc":9,"sub":null},{"cost":0,"ex":{"mem":null,"push":[],"store":null,"used":38982},"pc":10,"sub":null}]}`,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			st := tests.MakePreState(rawdb.NewMemoryDatabase(),
@@ -0,0 +1,144 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/live"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestTraceStoreTracer(t *testing.T) {
+	var (
+		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		caller = common.HexToAddress("0xaa")
+		callee = common.HexToAddress("0xbb")
+		engine = beacon.New(ethash.NewFaker())
+		path   = filepath.ToSlash(t.TempDir())
+	)
+	genesis := &core.Genesis{
+		Config: params.MergedTestChainConfig,
+		Alloc: types.GenesisAlloc{
+			addr: {Balance: big.NewInt(params.Ether)},
+			// The address 0xaa calls 0xbb, then stores the block number at slot 0x00
+			caller: {
+				Code: []byte{
+					byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+					byte(vm.PUSH1), 0xbb, byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+					byte(vm.NUMBER), byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+				},
+			},
+			// The address 0xbb stops immediately
+			callee: {Code: []byte{byte(vm.STOP)}},
+		},
+	}
+	signer := types.LatestSigner(genesis.Config)
+	_, blocks, _ := core.GenerateChainWithGenesis(genesis, engine, 4, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			Nonce:     uint64(i),
+			To:        &caller,
+			Gas:       100000,
+			GasFeeCap: b.BaseFee(),
+		})
+		b.AddTx(tx)
+	})
+	newStore := func() (*live.TraceStoreAPI, *core.BlockChain) {
+		hooks, apis, err := tracers.LiveDirectory.NewWithAPIs("tracestore", json.RawMessage(fmt.Sprintf(`{"path":"%s","depth":2}`, path)))
+		if err != nil {
+			t.Fatalf("failed to create trace store: %v", err)
+		}
+		options := core.DefaultConfig().WithStateScheme(rawdb.PathScheme)
+		options.VmConfig = vm.Config{Tracer: hooks}
+		chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), genesis, engine, options)
+		if err != nil {
+			t.Fatalf("failed to create tester chain: %v", err)
+		}
+		return apis[0].Service.(*live.TraceStoreAPI), chain
+	}
+	api, chain := newStore()
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	chain.Stop()
+
+	// Reopen the store, the traces must survive the restart.
+	api, chain = newStore()
+	defer chain.Stop()
+
+	if status := api.Status(); status.Tail != 3 || status.Head != 4 {
+		t.Fatalf("wrong retained range: tail %d, head %d", status.Tail, status.Head)
+	}
+	for _, number := range []rpc.BlockNumber{1, 2} {
+		if _, err := api.BlockByNumber(number); err == nil {
+			t.Errorf("block %d not pruned", number)
+		}
+	}
+	if _, err := api.BlockByHash(blocks[1].Hash()); err == nil {
+		t.Errorf("block %d not pruned", 2)
+	}
+	trace, err := api.BlockByHash(blocks[3].Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve block trace: %v", err)
+	}
+	latest, err := api.BlockByNumber(rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to retrieve latest block trace: %v", err)
+	}
+	if !reflect.DeepEqual(trace, latest) {
+		t.Fatalf("latest block trace mismatch")
+	}
+	if trace.Number != 4 || trace.Hash != blocks[3].Hash() {
+		t.Fatalf("wrong block traced: %d %x", trace.Number, trace.Hash)
+	}
+	if len(trace.Calls) != 2 {
+		t.Fatalf("wrong number of calls: %d", len(trace.Calls))
+	}
+	top, sub := trace.Calls[0], trace.Calls[1]
+	if top.From != addr || top.To != caller || top.Type != "CALL" || len(top.TraceAddress) != 0 || top.Subtraces != 1 {
+		t.Errorf("wrong top-level call: %+v", top)
+	}
+	if sub.From != caller || sub.To != callee || !reflect.DeepEqual(sub.TraceAddress, []int{0}) || sub.TxHash != blocks[3].Transactions()[0].Hash() {
+		t.Errorf("wrong sub call: %+v", sub)
+	}
+	diff := trace.StateDiff[caller]
+	if diff == nil || len(diff.Storage) != 1 {
+		t.Fatalf("missing storage diff of caller: %+v", diff)
+	}
+	if slot := diff.Storage[common.Hash{}]; slot.From != common.BigToHash(big.NewInt(3)) || slot.To != common.BigToHash(big.NewInt(4)) {
+		t.Errorf("wrong storage diff: %x -> %x", slot.From, slot.To)
+	}
+	if nonce := trace.StateDiff[addr].Nonce; nonce == nil || nonce.From != 3 || nonce.To != 4 {
+		t.Errorf("wrong nonce diff of sender: %+v", nonce)
+	}
+}
@@ -20,31 +20,70 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 type ctorFunc func(config json.RawMessage) (*tracing.Hooks, error)
 
+type ctorWithAPIsFunc func(config json.RawMessage) (*tracing.Hooks, []rpc.API, error)
+
+// CanonicalChain is the view of the canonical chain available to the APIs of
+// live tracers.
+type CanonicalChain interface {
+	// CurrentBlock returns the header of the canonical head block.
+	CurrentBlock() *types.Header
+
+	// GetCanonicalHash returns the hash of the canonical block with the given
+	// number, or the zero hash if it is not known.
+	GetCanonicalHash(number uint64) common.Hash
+}
+
+// ChainAware is implemented by the API services of live tracers which resolve
+// queries against the canonical chain. As tracers are created before the chain,
+// it is set once the chain is initialized, before the APIs are served.
+type ChainAware interface {
+	SetChain(chain CanonicalChain)
+}
+
 // LiveDirectory is the collection of tracers which can be used
 // during normal block import operations.
-var LiveDirectory = liveDirectory{elems: make(map[string]ctorFunc)}
+var LiveDirectory = liveDirectory{elems: make(map[string]ctorWithAPIsFunc)}
 
 type liveDirectory struct {
-	elems map[string]ctorFunc
+	elems map[string]ctorWithAPIsFunc
 }
 
 // Register registers a tracer constructor by name.
 func (d *liveDirectory) Register(name string, f ctorFunc) {
+	d.elems[name] = func(config json.RawMessage) (*tracing.Hooks, []rpc.API, error) {
+		hooks, err := f(config)
+		return hooks, nil, err
+	}
+}
+
+// RegisterWithAPIs registers the constructor of a tracer exposing RPC APIs,
+// e.g. to query the data it collected, by name.
+func (d *liveDirectory) RegisterWithAPIs(name string, f ctorWithAPIsFunc) {
 	d.elems[name] = f
 }
 
 // New instantiates a tracer by name.
 func (d *liveDirectory) New(name string, config json.RawMessage) (*tracing.Hooks, error) {
+	hooks, _, err := d.NewWithAPIs(name, config)
+	return hooks, err
+}
+
+// NewWithAPIs instantiates a tracer by name, also returning the RPC APIs
+// exposed by it.
+func (d *liveDirectory) NewWithAPIs(name string, config json.RawMessage) (*tracing.Hooks, []rpc.API, error) {
 	if len(config) == 0 {
 		config = json.RawMessage("{}")
 	}
 	if f, ok := d.elems[name]; ok {
 		return f(config)
 	}
-	return nil, errors.New("not found")
+	return nil, nil, errors.New("not found")
 }
@@ -0,0 +1,457 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package live
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func init() {
+	tracers.LiveDirectory.RegisterWithAPIs("tracestore", newTraceStore)
+}
+
+var (
+	// traceStoreBlockPrefix + num (uint64 big endian) + hash -> block trace
+	traceStoreBlockPrefix = []byte("b")
+
+	// traceStoreNumberPrefix + num (uint64 big endian) -> hash of the last traced block
+	traceStoreNumberPrefix = []byte("n")
+
+	// traceStoreHashPrefix + hash -> num (uint64 big endian)
+	traceStoreHashPrefix = []byte("h")
+
+	// traceStoreHeadKey tracks the number of the most recently traced block.
+	traceStoreHeadKey = []byte("LastBlock")
+
+	errTraceNotFound = errors.New("block trace not found")
+)
+
+// traceStoreConfig is the configuration of the trace store tracer.
+type traceStoreConfig struct {
+	Path    string `json:"path"`    // Path to the directory where the database is stored
+	Depth   uint64 `json:"depth"`   // Number of recent blocks to retain, zero retains all blocks
+	Cache   int    `json:"cache"`   // Megabytes of memory allocated to the database cache
+	Handles int    `json:"handles"` // Number of open files allowed for the database
+}
+
+// BlockTrace is the record stored for every traced block.
+type BlockTrace struct {
+	Number     hexutil.Uint64                       `json:"blockNumber"`
+	Hash       common.Hash                          `json:"blockHash"`
+	ParentHash common.Hash                          `json:"parentHash"`
+	Calls      []*FlatCall                          `json:"calls"`
+	StateDiff  map[common.Address]*AccountStateDiff `json:"stateDiff"`
+}
+
+// FlatCall is a single call frame of a transaction. The position of the frame
+// within the call tree is given by its trace address.
+type FlatCall struct {
+	TxHash       common.Hash    `json:"transactionHash"`
+	TxIndex      hexutil.Uint   `json:"transactionPosition"`
+	TraceAddress []int          `json:"traceAddress"`
+	Subtraces    int            `json:"subtraces"`
+	Type         string         `json:"type"`
+	From         common.Address `json:"from"`
+	To           common.Address `json:"to"`
+	Value        *hexutil.Big   `json:"value,omitempty"`
+	Gas          hexutil.Uint64 `json:"gas"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Input        hexutil.Bytes  `json:"input"`
+	Output       hexutil.Bytes  `json:"output,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	Reverted     bool           `json:"reverted,omitempty"`
+}
+
+// ValueDiff is the change of a value by a block.
+type ValueDiff[T any] struct {
+	From T `json:"from"`
+	To   T `json:"to"`
+}
+
+// AccountStateDiff is the change of an account by a block.
+type AccountStateDiff struct {
+	Created  bool                                    `json:"created,omitempty"`
+	Deleted  bool                                    `json:"deleted,omitempty"`
+	Balance  *ValueDiff[*hexutil.Big]                `json:"balance,omitempty"`
+	Nonce    *ValueDiff[hexutil.Uint64]              `json:"nonce,omitempty"`
+	CodeHash *ValueDiff[common.Hash]                 `json:"codeHash,omitempty"`
+	Storage  map[common.Hash]*ValueDiff[common.Hash] `json:"storage,omitempty"`
+}
+
+// traceStore is a live tracer recording the flattened call frames and the state
+// changes of every imported block into a dedicated database, so they can be
+// queried without re-executing the block.
+type traceStore struct {
+	db    ethdb.KeyValueStore
+	depth uint64
+
+	// Fields of the block being traced
+	block  *BlockTrace
+	txHash common.Hash
+	txIdx  int
+	inTx   bool
+	stack  []*FlatCall
+
+	lock sync.RWMutex
+	tail uint64 // Lowest block number which may be present in the database
+	head uint64 // Number of the most recently traced block
+}
+
+func newTraceStore(cfg json.RawMessage) (*tracing.Hooks, []rpc.API, error) {
+	var config traceStoreConfig
+	if err := json.Unmarshal(cfg, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse config: %v", err)
+	}
+	if config.Path == "" {
+		return nil, nil, errors.New("trace store path is required")
+	}
+	if config.Cache < 16 {
+		config.Cache = 16
+	}
+	if config.Handles < 16 {
+		config.Handles = 16
+	}
+	db, err := pebble.New(config.Path, config.Cache, config.Handles, "eth/tracers/live/tracestore/", false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open trace store: %v", err)
+	}
+	t := &traceStore{db: db, depth: config.Depth}
+
+	// Restore the retained range from the number index.
+	it := db.NewIterator(traceStoreNumberPrefix, nil)
+	if it.Next() {
+		t.tail = binary.BigEndian.Uint64(it.Key()[len(traceStoreNumberPrefix):])
+	}
+	it.Release()
+	if head, _ := db.Get(traceStoreHeadKey); len(head) == 8 {
+		t.head = binary.BigEndian.Uint64(head)
+	}
+
+	hooks := &tracing.Hooks{
+		OnBlockStart:  t.onBlockStart,
+		OnBlockEnd:    t.onBlockEnd,
+		OnTxStart:     t.onTxStart,
+		OnTxEnd:       t.onTxEnd,
+		OnEnter:       t.onEnter,
+		OnExit:        t.onExit,
+		OnStateUpdate: t.onStateUpdate,
+		OnClose:       t.onClose,
+	}
+	apis := []rpc.API{{
+		Namespace: "tracestore",
+		Service:   &TraceStoreAPI{store: t},
+	}}
+	return hooks, apis, nil
+}
+
+func (t *traceStore) onBlockStart(ev tracing.BlockEvent) {
+	t.block = &BlockTrace{
+		Number:     hexutil.Uint64(ev.Block.NumberU64()),
+		Hash:       ev.Block.Hash(),
+		ParentHash: ev.Block.ParentHash(),
+		Calls:      []*FlatCall{},
+		StateDiff:  make(map[common.Address]*AccountStateDiff),
+	}
+	t.txIdx = 0
+	t.inTx = false
+	t.stack = t.stack[:0]
+}
+
+func (t *traceStore) onTxStart(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.txHash = tx.Hash()
+	t.inTx = true
+	t.stack = t.stack[:0]
+}
+
+func (t *traceStore) onTxEnd(receipt *types.Receipt, err error) {
+	t.inTx = false
+	t.txIdx++
+}
+
+func (t *traceStore) onEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// System calls are executed outside of transactions, skip them.
+	if t.block == nil || !t.inTx {
+		return
+	}
+	call := &FlatCall{
+		TxHash:       t.txHash,
+		TxIndex:      hexutil.Uint(t.txIdx),
+		TraceAddress: []int{},
+		Type:         vm.OpCode(typ).String(),
+		From:         from,
+		To:           to,
+		Gas:          hexutil.Uint64(gas),
+		Input:        common.CopyBytes(input),
+	}
+	if value != nil {
+		call.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	if len(t.stack) > 0 {
+		parent := t.stack[len(t.stack)-1]
+		call.TraceAddress = append(append([]int{}, parent.TraceAddress...), parent.Subtraces)
+		parent.Subtraces++
+	}
+	t.block.Calls = append(t.block.Calls, call)
+	t.stack = append(t.stack, call)
+}
+
+func (t *traceStore) onExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if t.block == nil || !t.inTx || len(t.stack) == 0 {
+		return
+	}
+	call := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	call.GasUsed = hexutil.Uint64(gasUsed)
+	call.Output = common.CopyBytes(output)
+	call.Reverted = reverted
+	if err != nil {
+		call.Error = err.Error()
+	}
+}
+
+func (t *traceStore) onStateUpdate(update *tracing.StateUpdate) {
+	if t.block == nil || update.BlockNumber != uint64(t.block.Number) {
+		return
+	}
+	diff := func(addr common.Address) *AccountStateDiff {
+		if t.block.StateDiff[addr] == nil {
+			t.block.StateDiff[addr] = new(AccountStateDiff)
+		}
+		return t.block.StateDiff[addr]
+	}
+	for addr, change := range update.AccountChanges {
+		prev, next := change.Prev, change.New
+		if prev == nil {
+			prev = types.NewEmptyStateAccount()
+		}
+		if next == nil {
+			next = types.NewEmptyStateAccount()
+		}
+		d := diff(addr)
+		d.Created = change.Prev == nil
+		d.Deleted = change.New == nil
+		if prev.Balance.Cmp(next.Balance) != 0 {
+			d.Balance = &ValueDiff[*hexutil.Big]{
+				From: (*hexutil.Big)(prev.Balance.ToBig()),
+				To:   (*hexutil.Big)(next.Balance.ToBig()),
+			}
+		}
+		if prev.Nonce != next.Nonce {
+			d.Nonce = &ValueDiff[hexutil.Uint64]{From: hexutil.Uint64(prev.Nonce), To: hexutil.Uint64(next.Nonce)}
+		}
+		if prevHash, nextHash := common.BytesToHash(prev.CodeHash), common.BytesToHash(next.CodeHash); prevHash != nextHash {
+			d.CodeHash = &ValueDiff[common.Hash]{From: prevHash, To: nextHash}
+		}
+	}
+	for addr, slots := range update.StorageChanges {
+		d := diff(addr)
+		for slot, change := range slots {
+			if change.Prev == change.New {
+				continue
+			}
+			if d.Storage == nil {
+				d.Storage = make(map[common.Hash]*ValueDiff[common.Hash])
+			}
+			d.Storage[slot] = &ValueDiff[common.Hash]{From: change.Prev, To: change.New}
+		}
+	}
+}
+
+func (t *traceStore) onBlockEnd(err error) {
+	block := t.block
+	t.block = nil
+	if err != nil || block == nil {
+		return
+	}
+	blob, err := json.Marshal(block)
+	if err != nil {
+		log.Error("Failed to encode block trace", "number", block.Number, "hash", block.Hash, "err", err)
+		return
+	}
+	number := uint64(block.Number)
+	batch := t.db.NewBatch()
+	batch.Put(traceStoreBlockKey(number, block.Hash), blob)
+	batch.Put(traceStoreNumberKey(number), block.Hash.Bytes())
+	batch.Put(traceStoreHashKey(block.Hash), binary.BigEndian.AppendUint64(nil, number))
+	batch.Put(traceStoreHeadKey, binary.BigEndian.AppendUint64(nil, number))
+
+	// Drop the blocks which fell out of the retained range.
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.depth > 0 && number >= t.depth && number-t.depth+1 > t.tail {
+		limit := number - t.depth + 1
+		it := t.db.NewIterator(traceStoreBlockPrefix, traceStoreNumberKey(t.tail)[len(traceStoreNumberPrefix):])
+		for it.Next() {
+			key := it.Key()
+			if binary.BigEndian.Uint64(key[len(traceStoreBlockPrefix):]) >= limit {
+				break
+			}
+			batch.Delete(traceStoreHashKey(common.BytesToHash(key[len(traceStoreBlockPrefix)+8:])))
+		}
+		it.Release()
+		batch.DeleteRange(traceStoreNumberKey(t.tail), traceStoreNumberKey(limit))
+		batch.DeleteRange(traceStoreBlockKey(t.tail, common.Hash{}), traceStoreBlockKey(limit, common.Hash{}))
+		t.tail = limit
+	}
+	if err := batch.Write(); err != nil {
+		log.Error("Failed to write block trace", "number", number, "hash", block.Hash, "err", err)
+		return
+	}
+	t.head = number
+}
+
+func (t *traceStore) onClose() {
+	if err := t.db.Close(); err != nil {
+		log.Warn("Failed to close trace store", "err", err)
+	}
+}
+
+// readBlockByNumber retrieves the trace of a block by number, returning the one
+// most recently imported at the given height. This is not necessarily the
+// canonical one if side blocks were executed after it.
+func (t *traceStore) readBlockByNumber(number uint64) (*BlockTrace, error) {
+	hash, err := t.db.Get(traceStoreNumberKey(number))
+	if err != nil {
+		return nil, errTraceNotFound
+	}
+	return t.readBlock(number, common.BytesToHash(hash))
+}
+
+// readBlock retrieves the trace of a block by number and hash.
+func (t *traceStore) readBlock(number uint64, hash common.Hash) (*BlockTrace, error) {
+	blob, err := t.db.Get(traceStoreBlockKey(number, hash))
+	if err != nil {
+		return nil, errTraceNotFound
+	}
+	block := new(BlockTrace)
+	if err := json.Unmarshal(blob, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// readBlockByHash retrieves the trace of a block by hash.
+func (t *traceStore) readBlockByHash(hash common.Hash) (*BlockTrace, error) {
+	number, err := t.db.Get(traceStoreHashKey(hash))
+	if err != nil || len(number) != 8 {
+		return nil, errTraceNotFound
+	}
+	return t.readBlock(binary.BigEndian.Uint64(number), hash)
+}
+
+// traceStoreNumberKey = traceStoreNumberPrefix + num (uint64 big endian)
+func traceStoreNumberKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, traceStoreNumberPrefix...), number)
+}
+
+// traceStoreHashKey = traceStoreHashPrefix + hash
+func traceStoreHashKey(hash common.Hash) []byte {
+	return append(append([]byte{}, traceStoreHashPrefix...), hash.Bytes()...)
+}
+
+// traceStoreBlockKey = traceStoreBlockPrefix + num (uint64 big endian) + hash
+func traceStoreBlockKey(number uint64, hash common.Hash) []byte {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, traceStoreBlockPrefix...), number)
+	return append(key, hash.Bytes()...)
+}
+
+// TraceStoreAPI provides access to the block traces recorded by the trace
+// store live tracer.
+type TraceStoreAPI struct {
+	store *traceStore
+	chain tracers.CanonicalChain
+}
+
+// SetChain sets the chain used to resolve block numbers to canonical blocks.
+func (api *TraceStoreAPI) SetChain(chain tracers.CanonicalChain) {
+	api.chain = chain
+}
+
+// TraceStoreStatus reports the range of blocks retained by the trace store.
+type TraceStoreStatus struct {
+	Tail hexutil.Uint64 `json:"tail"`
+	Head hexutil.Uint64 `json:"head"`
+}
+
+// Status returns the range of blocks retained by the trace store.
+func (api *TraceStoreAPI) Status() TraceStoreStatus {
+	api.store.lock.RLock()
+	defer api.store.lock.RUnlock()
+
+	return TraceStoreStatus{Tail: hexutil.Uint64(api.store.tail), Head: hexutil.Uint64(api.store.head)}
+}
+
+// BlockByNumber returns the recorded call traces and state diff of the canonical
+// block with the given number. Without access to the chain, the block most
+// recently imported at the given height is returned instead.
+func (api *TraceStoreAPI) BlockByNumber(number rpc.BlockNumber) (*BlockTrace, error) {
+	if api.chain != nil {
+		return api.canonicalBlock(number)
+	}
+	switch {
+	case number == rpc.LatestBlockNumber:
+		api.store.lock.RLock()
+		head := api.store.head
+		api.store.lock.RUnlock()
+		return api.store.readBlockByNumber(head)
+	case number < 0:
+		return nil, fmt.Errorf("unsupported block number %v", number)
+	}
+	return api.store.readBlockByNumber(uint64(number))
+}
+
+// canonicalBlock returns the trace of the canonical block with the given number,
+// looking up its hash in the chain as the number index of the store follows the
+// blocks in the order they were executed, including side blocks.
+func (api *TraceStoreAPI) canonicalBlock(number rpc.BlockNumber) (*BlockTrace, error) {
+	switch {
+	case number == rpc.LatestBlockNumber:
+		head := api.chain.CurrentBlock()
+		return api.store.readBlock(head.Number.Uint64(), head.Hash())
+	case number < 0:
+		return nil, fmt.Errorf("unsupported block number %v", number)
+	}
+	hash := api.chain.GetCanonicalHash(uint64(number))
+	if hash == (common.Hash{}) {
+		return nil, errTraceNotFound
+	}
+	return api.store.readBlock(uint64(number), hash)
+}
+
+// BlockByHash returns the recorded call traces and state diff of the block with
+// the given hash.
+func (api *TraceStoreAPI) BlockByHash(hash common.Hash) (*BlockTrace, error) {
+	return api.store.readBlockByHash(hash)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package live
+
+import (
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testChain is a canonical chain made of a list of headers.
+type testChain []*types.Header
+
+func (c testChain) CurrentBlock() *types.Header {
+	return c[len(c)-1]
+}
+
+func (c testChain) GetCanonicalHash(number uint64) common.Hash {
+	if number >= uint64(len(c)) {
+		return common.Hash{}
+	}
+	return c[number].Hash()
+}
+
+// makeHeaders creates a chain of n headers on top of parent, with the extra
+// data distinguishing it from other branches.
+func makeHeaders(parent *types.Header, n int, extra string) []*types.Header {
+	headers := make([]*types.Header, n)
+	for i := range headers {
+		headers[i] = &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number, common.Big1),
+			Extra:      []byte(extra),
+		}
+		parent = headers[i]
+	}
+	return headers
+}
+
+// Tests that blocks are looked up by number on the canonical chain, regardless
+// of the order the blocks of the different branches were executed in.
+func TestTraceStoreReorg(t *testing.T) {
+	cfg, _ := json.Marshal(traceStoreConfig{Path: filepath.Join(t.TempDir(), "traces")})
+	hooks, apis, err := newTraceStore(cfg)
+	if err != nil {
+		t.Fatalf("failed to create trace store: %v", err)
+	}
+	defer hooks.OnClose()
+
+	var (
+		api     = apis[0].Service.(*TraceStoreAPI)
+		genesis = &types.Header{Number: common.Big0}
+		chainA  = makeHeaders(genesis, 2, "a")
+		chainB  = makeHeaders(genesis, 3, "b")
+	)
+	trace := func(headers ...*types.Header) {
+		for _, header := range headers {
+			hooks.OnBlockStart(tracing.BlockEvent{Block: types.NewBlockWithHeader(header)})
+			hooks.OnBlockEnd(nil)
+		}
+	}
+	check := func(number rpc.BlockNumber, want *types.Header) {
+		t.Helper()
+		block, err := api.BlockByNumber(number)
+		if err != nil {
+			t.Fatalf("block %d: failed to retrieve trace: %v", number, err)
+		}
+		if block.Hash != want.Hash() {
+			t.Fatalf("block %d: hash mismatch: have %x, want %x", number, block.Hash, want.Hash())
+		}
+	}
+	trace(chainA...)
+	api.SetChain(append(testChain{genesis}, chainA...))
+	check(1, chainA[0])
+	check(rpc.LatestBlockNumber, chainA[1])
+
+	// Reorg to the longer branch, then execute a side block on top of the old
+	// one, which must not shadow the canonical blocks.
+	trace(chainB...)
+	api.SetChain(append(testChain{genesis}, chainB...))
+	trace(makeHeaders(chainA[1], 1, "a")...)
+
+	check(1, chainB[0])
+	check(2, chainB[1])
+	check(3, chainB[2])
+	check(rpc.LatestBlockNumber, chainB[2])
+
+	if _, err := api.BlockByNumber(4); err != errTraceNotFound {
+		t.Fatalf("expected missing trace for unknown block, got %v", err)
+	}
+}
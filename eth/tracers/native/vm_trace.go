@@ -0,0 +1,268 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/internal"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("vmTracer", newVMTracer, false)
+}
+
+// vmTrace is the Parity-style representation of the execution of a single
+// call frame. Nested frames are attached to the operation which spawned them.
+type vmTrace struct {
+	Code hexutil.Bytes `json:"code"`
+	Ops  []*vmTraceOp  `json:"ops"`
+}
+
+// vmTraceOp is a single executed instruction within a vmTrace.
+type vmTraceOp struct {
+	Cost  uint64        `json:"cost"`
+	Ex    *vmTraceEx    `json:"ex"`
+	PC    uint64        `json:"pc"`
+	Sub   *vmTrace      `json:"sub"`
+	op    vm.OpCode     // Opcode executed, used to derive the effects
+	gas   uint64        // Gas available before executing the opcode
+	mem   *vmTraceMem   // Memory region written by the opcode, data filled in lazily
+	store *vmTraceStore // Storage slot written by the opcode
+}
+
+// vmTraceEx holds the effects of executing an instruction.
+type vmTraceEx struct {
+	Mem   *vmTraceMem    `json:"mem"`
+	Push  []hexutil.U256 `json:"push"`
+	Store *vmTraceStore  `json:"store"`
+	Used  uint64         `json:"used"`
+}
+
+// vmTraceMem is a memory write performed by an instruction.
+type vmTraceMem struct {
+	Data hexutil.Bytes `json:"data"`
+	Off  uint64        `json:"off"`
+	size uint64
+}
+
+// vmTraceStore is a storage write performed by an instruction.
+type vmTraceStore struct {
+	Key hexutil.U256 `json:"key"`
+	Val hexutil.U256 `json:"val"`
+}
+
+// vmTracer reports the executed instructions of a transaction in the format
+// used by the vmTrace mode of Parity's trace_replayTransaction.
+type vmTracer struct {
+	root      *vmTrace
+	frames    []*vmTrace // Call frames currently being executed
+	interrupt atomic.Bool
+	reason    error
+}
+
+// newVMTracer returns a new vmTracer.
+func newVMTracer(ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &vmTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnTxEnd:   t.OnTxEnd,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+			OnOpcode:  t.OnOpcode,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// OnTxStart resets the tracer for the execution of a new transaction.
+func (t *vmTracer) OnTxStart(env *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.root = nil
+	t.frames = t.frames[:0]
+}
+
+// OnTxEnd discards the recorded trace if the transaction failed validation.
+func (t *vmTracer) OnTxEnd(receipt *types.Receipt, err error) {
+	if err != nil {
+		t.root = nil
+	}
+}
+
+// OnEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *vmTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+	// Selfdestructs don't execute any code, skip them
+	if vm.OpCode(typ) == vm.SELFDESTRUCT {
+		return
+	}
+	frame := &vmTrace{Ops: []*vmTraceOp{}}
+	if depth == 0 {
+		t.root = frame
+	} else if parent := t.current(); parent != nil && len(parent.Ops) > 0 {
+		parent.Ops[len(parent.Ops)-1].Sub = frame
+	}
+	t.frames = append(t.frames, frame)
+}
+
+// OnExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *vmTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := t.current()
+	if frame == nil {
+		return
+	}
+	// The last instruction of the frame has no successor, derive its effects
+	// from the known cost only.
+	if n := len(frame.Ops); n > 0 && frame.Ops[n-1].Ex == nil && err == nil {
+		last := frame.Ops[n-1]
+		last.Ex = &vmTraceEx{Push: []hexutil.U256{}, Store: last.store}
+		if last.gas >= last.Cost {
+			last.Ex.Used = last.gas - last.Cost
+		}
+	}
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+// OnOpcode records the executed instruction and fills in the effects of the
+// previous instruction in the same frame.
+func (t *vmTracer) OnOpcode(pc uint64, opcode byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := t.current()
+	if frame == nil {
+		return
+	}
+	if len(frame.Ops) == 0 {
+		frame.Code = scope.ContractCode()
+	} else {
+		t.finalize(frame.Ops[len(frame.Ops)-1], gas, scope)
+	}
+	op := &vmTraceOp{
+		Cost: cost,
+		PC:   pc,
+		op:   vm.OpCode(opcode),
+		gas:  gas,
+	}
+	stack := scope.StackData()
+	peek := func(n int) uint64 {
+		if len(stack) <= n {
+			return 0
+		}
+		return stack[len(stack)-1-n].Uint64()
+	}
+	switch op.op {
+	case vm.MSTORE:
+		op.mem = &vmTraceMem{Off: peek(0), size: 32}
+	case vm.MSTORE8:
+		op.mem = &vmTraceMem{Off: peek(0), size: 1}
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		op.mem = &vmTraceMem{Off: peek(0), size: peek(2)}
+	case vm.EXTCODECOPY:
+		op.mem = &vmTraceMem{Off: peek(1), size: peek(3)}
+	case vm.SSTORE:
+		if len(stack) >= 2 {
+			op.store = &vmTraceStore{Key: hexutil.U256(stack[len(stack)-1]), Val: hexutil.U256(stack[len(stack)-2])}
+		}
+	}
+	frame.Ops = append(frame.Ops, op)
+}
+
+// finalize fills in the effects of an executed instruction, given the gas
+// and the scope observed at the next instruction of the same frame.
+func (t *vmTracer) finalize(op *vmTraceOp, gas uint64, scope tracing.OpContext) {
+	ex := &vmTraceEx{Used: gas, Push: []hexutil.U256{}}
+	stack := scope.StackData()
+	n := vmTracePushCount(op.op)
+	if n > len(stack) {
+		n = len(stack)
+	}
+	for i := len(stack) - n; i < len(stack); i++ {
+		ex.Push = append(ex.Push, hexutil.U256(stack[i]))
+	}
+	if op.mem != nil && op.mem.size > 0 {
+		data, err := internal.GetMemoryCopyPadded(scope.MemoryData(), int64(op.mem.Off), int64(op.mem.size))
+		if err == nil {
+			ex.Mem = &vmTraceMem{Off: op.mem.Off, Data: data}
+		}
+	}
+	ex.Store = op.store
+	op.Ex = ex
+}
+
+// GetResult returns the json-encoded vmTrace of the transaction, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *vmTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return nil, errors.New("no call frame recorded")
+	}
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+func (t *vmTracer) current() *vmTrace {
+	if len(t.frames) == 0 {
+		return nil
+	}
+	return t.frames[len(t.frames)-1]
+}
+
+// vmTracePushCount returns the number of stack items reported as pushed by
+// an opcode. Following Parity, DUPn and SWAPn report the whole affected range.
+func vmTracePushCount(op vm.OpCode) int {
+	switch {
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return 0
+	}
+	switch op {
+	case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI,
+		vm.JUMPDEST, vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY,
+		vm.MCOPY, vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID:
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,539 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// maxTraceFilterRange is the maximum number of blocks a single trace_filter
+	// request is allowed to re-execute.
+	maxTraceFilterRange = 10000
+
+	// Names of the native tracers backing the trace namespace.
+	flatCallTracerName = "flatCallTracer"
+	prestateTracerName = "prestateTracer"
+	vmTracerName       = "vmTracer"
+	muxTracerName      = "muxTracer"
+)
+
+// Replay modes supported by trace_replayTransaction and trace_replayBlockTransactions.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+var (
+	errTraceFilterRange = fmt.Errorf("trace_filter range exceeds the maximum of %d blocks", maxTraceFilterRange)
+	errInvalidTraceType = errors.New("invalid trace type")
+)
+
+// TraceAPI is the collection of Parity-style tracing APIs exposed over the
+// trace namespace. All methods are implemented on top of the native tracers
+// of the debug namespace.
+type TraceAPI struct {
+	api *API
+}
+
+// NewTraceAPI creates a new API definition for the Parity-style tracing
+// methods of the Ethereum service.
+func NewTraceAPI(backend Backend) *TraceAPI {
+	return &TraceAPI{api: NewAPI(backend)}
+}
+
+// TraceFilterArgs represents the arguments of a trace_filter request.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// TraceResults is the result of replaying a transaction with a set of trace
+// types. Fields belonging to trace types which were not requested are null.
+type TraceResults struct {
+	Output          hexutil.Bytes     `json:"output"`
+	StateDiff       StateDiff         `json:"stateDiff"`
+	Trace           []json.RawMessage `json:"trace"`
+	VMTrace         json.RawMessage   `json:"vmTrace"`
+	TransactionHash *common.Hash      `json:"transactionHash,omitempty"`
+}
+
+// StateDiff is the Parity-style representation of the state modifications
+// done by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff contains the changes done to a single account.
+type AccountDiff struct {
+	Balance *Delta                 `json:"balance"`
+	Code    *Delta                 `json:"code"`
+	Nonce   *Delta                 `json:"nonce"`
+	Storage map[common.Hash]*Delta `json:"storage"`
+}
+
+// Delta is the change of a single state field. A field can either be born
+// ("+"), die ("-"), change ("*") or remain the same ("=").
+type Delta struct {
+	Kind string
+	From interface{}
+	To   interface{}
+}
+
+// MarshalJSON marshals the delta in the Parity format.
+func (d *Delta) MarshalJSON() ([]byte, error) {
+	switch d.Kind {
+	case "=":
+		return json.Marshal("=")
+	case "+":
+		return json.Marshal(map[string]interface{}{"+": d.To})
+	case "-":
+		return json.Marshal(map[string]interface{}{"-": d.From})
+	case "*":
+		return json.Marshal(map[string]interface{}{"*": map[string]interface{}{"from": d.From, "to": d.To}})
+	}
+	return nil, fmt.Errorf("invalid delta kind %q", d.Kind)
+}
+
+// Block returns the flattened call traces of all transactions in a block.
+func (t *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) ([]json.RawMessage, error) {
+	block, err := t.api.blockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return t.blockTraces(ctx, block)
+}
+
+// Transaction returns the flattened call traces of a mined transaction.
+func (t *TraceAPI) Transaction(ctx context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	res, err := t.api.TraceTransaction(ctx, hash, flatCallTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+	return decodeFlatTraces(res)
+}
+
+// ReplayTransaction re-executes a mined transaction and returns the requested
+// trace types, any combination of trace, stateDiff and vmTrace.
+func (t *TraceAPI) ReplayTransaction(ctx context.Context, hash common.Hash, traceTypes []string) (*TraceResults, error) {
+	config, err := replayTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	res, err := t.api.TraceTransaction(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+	return decodeReplayResult(res, traceTypes)
+}
+
+// ReplayBlockTransactions re-executes all transactions of a block and returns
+// the requested trace types for each of them.
+func (t *TraceAPI) ReplayBlockTransactions(ctx context.Context, number rpc.BlockNumber, traceTypes []string) ([]*TraceResults, error) {
+	config, err := replayTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	block, err := t.api.blockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	results, err := t.api.traceBlock(ctx, block, config)
+	if err != nil {
+		return nil, err
+	}
+	replays := make([]*TraceResults, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, errors.New(result.Error)
+		}
+		replay, err := decodeReplayResult(result.Result, traceTypes)
+		if err != nil {
+			return nil, err
+		}
+		hash := result.TxHash
+		replay.TransactionHash = &hash
+		replays[i] = replay
+	}
+	return replays, nil
+}
+
+// Filter returns the flattened call traces within a block range matching the
+// given sender and recipient addresses.
+func (t *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) ([]json.RawMessage, error) {
+	from, err := t.resolveBlockNumber(ctx, args.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := t.resolveBlockNumber(ctx, args.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	if to-from >= maxTraceFilterRange {
+		return nil, errTraceFilterRange
+	}
+	var (
+		skip    uint64
+		matches = []json.RawMessage{}
+	)
+	if args.After != nil {
+		skip = *args.After
+	}
+	for number := from; number <= to; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if number == 0 {
+			continue // genesis is not traceable
+		}
+		block, err := t.api.blockByNumber(ctx, rpc.BlockNumber(number))
+		if err != nil {
+			return nil, err
+		}
+		if len(block.Transactions()) == 0 {
+			continue
+		}
+		traces, err := t.blockTraces(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			ok, err := filterFlatTrace(trace, args.FromAddress, args.ToAddress)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			matches = append(matches, trace)
+			if args.Count != nil && uint64(len(matches)) >= *args.Count {
+				return matches, nil
+			}
+		}
+	}
+	return matches, nil
+}
+
+// blockTraces traces all transactions of a block with the flat call tracer
+// and concatenates the results.
+func (t *TraceAPI) blockTraces(ctx context.Context, block *types.Block) ([]json.RawMessage, error) {
+	results, err := t.api.traceBlock(ctx, block, flatCallTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+	traces := []json.RawMessage{}
+	for _, result := range results {
+		if result.Error != "" {
+			return nil, errors.New(result.Error)
+		}
+		flat, err := decodeFlatTraces(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, flat...)
+	}
+	return traces, nil
+}
+
+// resolveBlockNumber converts an optional block number, potentially a tag,
+// into a concrete one. Missing numbers default to the latest block.
+func (t *TraceAPI) resolveBlockNumber(ctx context.Context, number *rpc.BlockNumber) (uint64, error) {
+	if number == nil {
+		return t.api.backend.CurrentHeader().Number.Uint64(), nil
+	}
+	if *number >= 0 {
+		return uint64(*number), nil
+	}
+	header, err := t.api.backend.HeaderByNumber(ctx, *number)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil {
+		return 0, fmt.Errorf("block #%d not found", *number)
+	}
+	return header.Number.Uint64(), nil
+}
+
+// flatCallTraceConfig returns the trace configuration producing Parity-style
+// flat call traces.
+func flatCallTraceConfig() *TraceConfig {
+	tracer := flatCallTracerName
+	return &TraceConfig{
+		Tracer:       &tracer,
+		TracerConfig: json.RawMessage(`{"convertParityErrors":true}`),
+	}
+}
+
+// replayTraceConfig returns the configuration of a mux tracer running all
+// tracers needed to serve the requested trace types in a single execution.
+func replayTraceConfig(traceTypes []string) (*TraceConfig, error) {
+	// The call tracer is always needed to retrieve the output
+	tracers := map[string]json.RawMessage{
+		flatCallTracerName: json.RawMessage(`{"convertParityErrors":true}`),
+	}
+	for _, typ := range traceTypes {
+		switch typ {
+		case TraceTypeTrace:
+		case TraceTypeStateDiff:
+			tracers[prestateTracerName] = json.RawMessage(`{"diffMode":true}`)
+		case TraceTypeVMTrace:
+			tracers[vmTracerName] = json.RawMessage(`{}`)
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidTraceType, typ)
+		}
+	}
+	cfg, err := json.Marshal(tracers)
+	if err != nil {
+		return nil, err
+	}
+	tracer := muxTracerName
+	return &TraceConfig{Tracer: &tracer, TracerConfig: cfg}, nil
+}
+
+// decodeFlatTraces splits the result of the flat call tracer into its frames.
+func decodeFlatTraces(res interface{}) ([]json.RawMessage, error) {
+	blob, ok := res.(json.RawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected trace result type %T", res)
+	}
+	var traces []json.RawMessage
+	if err := json.Unmarshal(blob, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// decodeReplayResult converts the output of the replay mux tracer into the
+// Parity replay format.
+func decodeReplayResult(res interface{}, traceTypes []string) (*TraceResults, error) {
+	blob, ok := res.(json.RawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected trace result type %T", res)
+	}
+	var outputs map[string]json.RawMessage
+	if err := json.Unmarshal(blob, &outputs); err != nil {
+		return nil, err
+	}
+	traces, err := decodeFlatTraces(outputs[flatCallTracerName])
+	if err != nil {
+		return nil, err
+	}
+	result := new(TraceResults)
+	if len(traces) > 0 {
+		var top struct {
+			Result *struct {
+				Code   hexutil.Bytes `json:"code"`
+				Output hexutil.Bytes `json:"output"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(traces[0], &top); err != nil {
+			return nil, err
+		}
+		if top.Result != nil {
+			result.Output = top.Result.Output
+			if top.Result.Code != nil {
+				result.Output = top.Result.Code
+			}
+		}
+	}
+	if result.Output == nil {
+		result.Output = hexutil.Bytes{}
+	}
+	if slices.Contains(traceTypes, TraceTypeTrace) {
+		result.Trace = make([]json.RawMessage, len(traces))
+		for i, trace := range traces {
+			if result.Trace[i], err = stripTraceLocation(trace); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if slices.Contains(traceTypes, TraceTypeStateDiff) {
+		if result.StateDiff, err = decodeStateDiff(outputs[prestateTracerName]); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(traceTypes, TraceTypeVMTrace) {
+		result.VMTrace = outputs[vmTracerName]
+	}
+	return result, nil
+}
+
+// stripTraceLocation removes the block and transaction positioning from a
+// flat call frame, which replayed traces don't carry.
+func stripTraceLocation(trace json.RawMessage) (json.RawMessage, error) {
+	var frame map[string]json.RawMessage
+	if err := json.Unmarshal(trace, &frame); err != nil {
+		return nil, err
+	}
+	delete(frame, "blockHash")
+	delete(frame, "blockNumber")
+	delete(frame, "transactionHash")
+	delete(frame, "transactionPosition")
+	return json.Marshal(frame)
+}
+
+// filterFlatTrace reports whether a flat call frame matches the given sender
+// and recipient sets. Empty sets match any address.
+func filterFlatTrace(trace json.RawMessage, fromAddrs, toAddrs []common.Address) (bool, error) {
+	if len(fromAddrs) == 0 && len(toAddrs) == 0 {
+		return true, nil
+	}
+	var frame struct {
+		Action struct {
+			From          *common.Address `json:"from"`
+			To            *common.Address `json:"to"`
+			Address       *common.Address `json:"address"`
+			RefundAddress *common.Address `json:"refundAddress"`
+		} `json:"action"`
+		Result *struct {
+			Address *common.Address `json:"address"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(trace, &frame); err != nil {
+		return false, err
+	}
+	matches := func(set []common.Address, candidates ...*common.Address) bool {
+		if len(set) == 0 {
+			return true
+		}
+		for _, addr := range candidates {
+			if addr != nil && slices.Contains(set, *addr) {
+				return true
+			}
+		}
+		return false
+	}
+	var created *common.Address
+	if frame.Result != nil {
+		created = frame.Result.Address
+	}
+	return matches(fromAddrs, frame.Action.From, frame.Action.Address) &&
+		matches(toAddrs, frame.Action.To, frame.Action.RefundAddress, created), nil
+}
+
+// prestateAccount is the account format produced by the prestate tracer.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// decodeStateDiff converts the output of the prestate tracer running in diff
+// mode into the Parity stateDiff format.
+func decodeStateDiff(blob json.RawMessage) (StateDiff, error) {
+	var diff struct {
+		Pre  map[common.Address]*prestateAccount `json:"pre"`
+		Post map[common.Address]*prestateAccount `json:"post"`
+	}
+	if err := json.Unmarshal(blob, &diff); err != nil {
+		return nil, err
+	}
+	result := make(StateDiff)
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// Accounts only present in the prestate have been destructed
+			result[addr] = &AccountDiff{
+				Balance: &Delta{Kind: "-", From: orZeroBig(pre.Balance)},
+				Code:    &Delta{Kind: "-", From: orEmptyCode(pre.Code)},
+				Nonce:   &Delta{Kind: "-", From: hexutil.Uint64(pre.Nonce)},
+				Storage: make(map[common.Hash]*Delta),
+			}
+			for slot, val := range pre.Storage {
+				result[addr].Storage[slot] = &Delta{Kind: "-", From: val}
+			}
+			continue
+		}
+		account := &AccountDiff{
+			Balance: &Delta{Kind: "="},
+			Code:    &Delta{Kind: "="},
+			Nonce:   &Delta{Kind: "="},
+			Storage: make(map[common.Hash]*Delta),
+		}
+		if post.Balance != nil {
+			account.Balance = &Delta{Kind: "*", From: orZeroBig(pre.Balance), To: post.Balance}
+		}
+		if post.Code != nil {
+			account.Code = &Delta{Kind: "*", From: orEmptyCode(pre.Code), To: post.Code}
+		}
+		if post.Nonce != 0 {
+			account.Nonce = &Delta{Kind: "*", From: hexutil.Uint64(pre.Nonce), To: hexutil.Uint64(post.Nonce)}
+		}
+		// Slots cleared by the transaction are omitted from the poststate,
+		// slots populated from zero are omitted from the prestate.
+		for slot, val := range pre.Storage {
+			account.Storage[slot] = &Delta{Kind: "*", From: val, To: post.Storage[slot]}
+		}
+		for slot, val := range post.Storage {
+			if _, ok := pre.Storage[slot]; !ok {
+				account.Storage[slot] = &Delta{Kind: "*", From: common.Hash{}, To: val}
+			}
+		}
+		result[addr] = account
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; ok {
+			continue
+		}
+		// Accounts only present in the poststate have been created
+		result[addr] = &AccountDiff{
+			Balance: &Delta{Kind: "+", To: orZeroBig(post.Balance)},
+			Code:    &Delta{Kind: "+", To: orEmptyCode(post.Code)},
+			Nonce:   &Delta{Kind: "+", To: hexutil.Uint64(post.Nonce)},
+			Storage: make(map[common.Hash]*Delta),
+		}
+		for slot, val := range post.Storage {
+			result[addr].Storage[slot] = &Delta{Kind: "+", To: val}
+		}
+	}
+	return result, nil
+}
+
+func orZeroBig(b *hexutil.Big) *hexutil.Big {
+	if b == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return b
+}
+
+func orEmptyCode(code hexutil.Bytes) hexutil.Bytes {
+	if code == nil {
+		return hexutil.Bytes{}
+	}
+	return code
+}
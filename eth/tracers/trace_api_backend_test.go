@@ -0,0 +1,249 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	traceKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	traceSender = crypto.PubkeyToAddress(traceKey.PublicKey)
+	traceCaller = common.HexToAddress("0xaa")
+	traceCallee = common.HexToAddress("0xbb")
+	traceRecv   = common.HexToAddress("0xcc")
+)
+
+// flatTrace is the subset of a Parity-style flat call trace checked by the tests.
+type flatTrace struct {
+	Action struct {
+		From common.Address `json:"from"`
+		To   common.Address `json:"to"`
+	} `json:"action"`
+	BlockNumber     uint64      `json:"blockNumber"`
+	TraceAddress    []int       `json:"traceAddress"`
+	TransactionHash common.Hash `json:"transactionHash"`
+	Type            string      `json:"type"`
+}
+
+// newTraceAPI creates a chain of three blocks, each calling the contract at
+// 0xaa, which calls 0xbb and stores 1 at slot 0. The second block additionally
+// transfers ether to 0xcc, this transaction is returned last.
+func newTraceAPI(t *testing.T) (*tracers.TraceAPI, []*types.Transaction) {
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			traceSender: {Balance: big.NewInt(params.Ether)},
+			traceCaller: {
+				Code: []byte{
+					byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+					byte(vm.PUSH1), 0xbb, byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+					byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+				},
+			},
+			traceCallee: {Code: []byte{byte(vm.STOP)}},
+		},
+	}
+	var (
+		signer   = types.LatestSigner(genesis.Config)
+		txs      []*types.Transaction
+		transfer *types.Transaction
+	)
+	backend := tracers.NewTestBackend(t, 3, genesis, func(i int, b *core.BlockGen) {
+		tx := types.MustSignNewTx(traceKey, signer, &types.LegacyTx{
+			Nonce:    b.TxNonce(traceSender),
+			To:       &traceCaller,
+			Gas:      100000,
+			GasPrice: b.BaseFee(),
+		})
+		b.AddTx(tx)
+		txs = append(txs, tx)
+
+		if i == 1 {
+			transfer = types.MustSignNewTx(traceKey, signer, &types.LegacyTx{
+				Nonce:    b.TxNonce(traceSender),
+				To:       &traceRecv,
+				Value:    big.NewInt(1000),
+				Gas:      params.TxGas,
+				GasPrice: b.BaseFee(),
+			})
+			b.AddTx(transfer)
+		}
+	})
+	t.Cleanup(backend.Teardown)
+	return tracers.NewTraceAPI(backend), append(txs, transfer)
+}
+
+func decodeTraces(t *testing.T, traces []json.RawMessage) []flatTrace {
+	t.Helper()
+
+	decoded := make([]flatTrace, len(traces))
+	for i, trace := range traces {
+		if err := json.Unmarshal(trace, &decoded[i]); err != nil {
+			t.Fatalf("trace %d: failed to decode: %v", i, err)
+		}
+	}
+	return decoded
+}
+
+func TestTraceAPIBlock(t *testing.T) {
+	t.Parallel()
+
+	api, txs := newTraceAPI(t)
+	traces, err := api.Block(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	have := decodeTraces(t, traces)
+	if len(have) != 2 {
+		t.Fatalf("wrong number of traces: have %d, want 2", len(have))
+	}
+	for i, want := range []struct {
+		from, to     common.Address
+		traceAddress []int
+	}{
+		{traceSender, traceCaller, []int{}},
+		{traceCaller, traceCallee, []int{0}},
+	} {
+		trace := have[i]
+		if trace.Type != "call" || trace.Action.From != want.from || trace.Action.To != want.to {
+			t.Errorf("trace %d: wrong call: have %s %x -> %x, want call %x -> %x", i, trace.Type, trace.Action.From, trace.Action.To, want.from, want.to)
+		}
+		if len(trace.TraceAddress) != len(want.traceAddress) || (len(want.traceAddress) > 0 && trace.TraceAddress[0] != want.traceAddress[0]) {
+			t.Errorf("trace %d: trace address mismatch: have %v, want %v", i, trace.TraceAddress, want.traceAddress)
+		}
+		if trace.BlockNumber != 1 || trace.TransactionHash != txs[0].Hash() {
+			t.Errorf("trace %d: location mismatch: have block %d tx %x, want block 1 tx %x", i, trace.BlockNumber, trace.TransactionHash, txs[0].Hash())
+		}
+	}
+	if _, err := api.Block(context.Background(), 4); err == nil {
+		t.Fatal("expected error for unknown block")
+	}
+}
+
+func TestTraceAPIFilter(t *testing.T) {
+	t.Parallel()
+
+	api, txs := newTraceAPI(t)
+	var (
+		from   = rpc.BlockNumber(1)
+		to     = rpc.BlockNumber(3)
+		after  = uint64(1)
+		count  = uint64(1)
+		latest = rpc.LatestBlockNumber
+	)
+	tests := []struct {
+		args tracers.TraceFilterArgs
+		want []common.Hash // transactions of the matching traces
+	}{
+		// All traces of the range
+		{
+			args: tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &to},
+			want: []common.Hash{txs[0].Hash(), txs[0].Hash(), txs[1].Hash(), txs[1].Hash(), txs[3].Hash(), txs[2].Hash(), txs[2].Hash()},
+		},
+		// Traces by recipient
+		{
+			args: tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &latest, ToAddress: []common.Address{traceCallee}},
+			want: []common.Hash{txs[0].Hash(), txs[1].Hash(), txs[2].Hash()},
+		},
+		// Traces by sender, paginated
+		{
+			args: tracers.TraceFilterArgs{FromBlock: &from, FromAddress: []common.Address{traceCaller}, After: &after, Count: &count},
+			want: []common.Hash{txs[1].Hash()},
+		},
+	}
+	for i, tt := range tests {
+		traces, err := api.Filter(context.Background(), tt.args)
+		if err != nil {
+			t.Fatalf("test %d: failed to filter traces: %v", i, err)
+		}
+		have := decodeTraces(t, traces)
+		if len(have) != len(tt.want) {
+			t.Fatalf("test %d: wrong number of traces: have %d, want %d", i, len(have), len(tt.want))
+		}
+		for j, trace := range have {
+			if trace.TransactionHash != tt.want[j] {
+				t.Errorf("test %d, trace %d: transaction mismatch: have %x, want %x", i, j, trace.TransactionHash, tt.want[j])
+			}
+		}
+	}
+	// Ranges exceeding the limit are rejected before executing any block.
+	var (
+		zero  = rpc.BlockNumber(0)
+		limit = rpc.BlockNumber(tracers.MaxTraceFilterRange)
+	)
+	if _, err := api.Filter(context.Background(), tracers.TraceFilterArgs{FromBlock: &zero, ToBlock: &limit}); !errors.Is(err, tracers.ErrTraceFilterRange) {
+		t.Fatalf("range limit error mismatch: have %v, want %v", err, tracers.ErrTraceFilterRange)
+	}
+	if _, err := api.Filter(context.Background(), tracers.TraceFilterArgs{FromBlock: &to, ToBlock: &from}); err == nil {
+		t.Fatal("expected error for inverted range")
+	}
+}
+
+func TestTraceAPIReplayStateDiff(t *testing.T) {
+	t.Parallel()
+
+	api, txs := newTraceAPI(t)
+	result, err := api.ReplayTransaction(context.Background(), txs[1].Hash(), []string{tracers.TraceTypeStateDiff})
+	if err != nil {
+		t.Fatalf("failed to replay transaction: %v", err)
+	}
+	if result.Trace != nil || result.VMTrace != nil {
+		t.Errorf("unrequested trace types returned: trace %v, vmTrace %s", result.Trace, result.VMTrace)
+	}
+	// The slot was already set by the first block, the contract is unchanged.
+	if diff := result.StateDiff[traceCaller]; diff != nil {
+		t.Errorf("unexpected state diff of unchanged contract: %+v", diff)
+	}
+	sender := result.StateDiff[traceSender]
+	if sender == nil {
+		t.Fatalf("missing state diff of %x", traceSender)
+	}
+	have, _ := json.Marshal(sender.Nonce)
+	if want := `{"*":{"from":"0x1","to":"0x2"}}`; string(have) != want {
+		t.Errorf("sender nonce diff mismatch: have %s, want %s", have, want)
+	}
+	// The first transaction sets the slot.
+	result, err = api.ReplayTransaction(context.Background(), txs[0].Hash(), []string{tracers.TraceTypeTrace, tracers.TraceTypeStateDiff})
+	if err != nil {
+		t.Fatalf("failed to replay transaction: %v", err)
+	}
+	if len(result.Trace) != 2 {
+		t.Errorf("wrong number of traces: have %d, want 2", len(result.Trace))
+	}
+	have, _ = json.Marshal(result.StateDiff[traceCaller].Storage[common.Hash{}])
+	if want := `{"*":{"from":"0x0000000000000000000000000000000000000000000000000000000000000000","to":"0x0000000000000000000000000000000000000000000000000000000000000001"}}`; string(have) != want {
+		t.Errorf("storage diff mismatch: have %s, want %s", have, want)
+	}
+	if _, err := api.ReplayTransaction(context.Background(), txs[0].Hash(), []string{"foo"}); err == nil {
+		t.Fatal("expected error for invalid trace type")
+	}
+}
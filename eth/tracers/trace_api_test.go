@@ -0,0 +1,123 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDecodeStateDiff(t *testing.T) {
+	t.Parallel()
+
+	prestate := `{
+		"pre": {
+			"0x0000000000000000000000000000000000000001": {"balance": "0x10", "nonce": 1},
+			"0x0000000000000000000000000000000000000002": {"balance": "0x0", "code": "0x6000", "storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000005"
+			}},
+			"0x0000000000000000000000000000000000000003": {"balance": "0x1"}
+		},
+		"post": {
+			"0x0000000000000000000000000000000000000001": {"balance": "0x8", "nonce": 2},
+			"0x0000000000000000000000000000000000000002": {"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000007"
+			}},
+			"0x0000000000000000000000000000000000000004": {"balance": "0x2", "code": "0x00"}
+		}
+	}`
+	diff, err := decodeStateDiff(json.RawMessage(prestate))
+	if err != nil {
+		t.Fatalf("failed to decode state diff: %v", err)
+	}
+	have, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("failed to encode state diff: %v", err)
+	}
+	want := `{"0x0000000000000000000000000000000000000001":{"balance":{"*":{"from":"0x10","to":"0x8"}},"code":"=","nonce":{"*":{"from":"0x1","to":"0x2"}},"storage":{}},` +
+		`"0x0000000000000000000000000000000000000002":{"balance":"=","code":"=","nonce":"=","storage":{` +
+		`"0x0000000000000000000000000000000000000000000000000000000000000001":{"*":{"from":"0x0000000000000000000000000000000000000000000000000000000000000005","to":"0x0000000000000000000000000000000000000000000000000000000000000000"}},` +
+		`"0x0000000000000000000000000000000000000000000000000000000000000002":{"*":{"from":"0x0000000000000000000000000000000000000000000000000000000000000000","to":"0x0000000000000000000000000000000000000000000000000000000000000007"}}}},` +
+		`"0x0000000000000000000000000000000000000003":{"balance":{"-":"0x1"},"code":{"-":"0x"},"nonce":{"-":"0x0"},"storage":{}},` +
+		`"0x0000000000000000000000000000000000000004":{"balance":{"+":"0x2"},"code":{"+":"0x00"},"nonce":{"+":"0x0"},"storage":{}}}`
+	if string(have) != want {
+		t.Fatalf("state diff mismatch\nhave: %s\nwant: %s", have, want)
+	}
+}
+
+func TestFilterFlatTrace(t *testing.T) {
+	t.Parallel()
+
+	var (
+		a = common.HexToAddress("0xaa")
+		b = common.HexToAddress("0xbb")
+		c = common.HexToAddress("0xcc")
+
+		call    = json.RawMessage(`{"action":{"from":"0x00000000000000000000000000000000000000aa","to":"0x00000000000000000000000000000000000000bb","callType":"call"},"type":"call"}`)
+		create  = json.RawMessage(`{"action":{"from":"0x00000000000000000000000000000000000000aa"},"result":{"address":"0x00000000000000000000000000000000000000cc"},"type":"create"}`)
+		suicide = json.RawMessage(`{"action":{"address":"0x00000000000000000000000000000000000000cc","refundAddress":"0x00000000000000000000000000000000000000bb"},"type":"suicide"}`)
+	)
+	tests := []struct {
+		trace json.RawMessage
+		from  []common.Address
+		to    []common.Address
+		want  bool
+	}{
+		{call, nil, nil, true},
+		{call, []common.Address{a}, nil, true},
+		{call, []common.Address{b}, nil, false},
+		{call, nil, []common.Address{b}, true},
+		{call, []common.Address{a}, []common.Address{c}, false},
+		{create, nil, []common.Address{c}, true},
+		{create, []common.Address{c}, nil, false},
+		{suicide, []common.Address{c}, []common.Address{b}, true},
+		{suicide, []common.Address{a}, nil, false},
+	}
+	for i, tt := range tests {
+		have, err := filterFlatTrace(tt.trace, tt.from, tt.to)
+		if err != nil {
+			t.Fatalf("test %d: failed to filter trace: %v", i, err)
+		}
+		if have != tt.want {
+			t.Errorf("test %d: filter mismatch, have %v want %v", i, have, tt.want)
+		}
+	}
+}
+
+func TestReplayTraceConfig(t *testing.T) {
+	t.Parallel()
+
+	if _, err := replayTraceConfig([]string{"trace", "foo"}); !errors.Is(err, errInvalidTraceType) {
+		t.Fatalf("unexpected error for invalid trace type: %v", err)
+	}
+	config, err := replayTraceConfig([]string{TraceTypeStateDiff, TraceTypeVMTrace})
+	if err != nil {
+		t.Fatalf("failed to create replay config: %v", err)
+	}
+	var tracers map[string]json.RawMessage
+	if err := json.Unmarshal(config.TracerConfig, &tracers); err != nil {
+		t.Fatalf("invalid mux config: %v", err)
+	}
+	for _, name := range []string{flatCallTracerName, prestateTracerName, vmTracerName} {
+		if _, ok := tracers[name]; !ok {
+			t.Errorf("missing tracer %s in mux config", name)
+		}
+	}
+}
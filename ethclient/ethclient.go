@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/internal/rpcargs"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -381,8 +382,11 @@ func (ec *Client) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, err
 
 // SubscribeNewHead subscribes to notifications about the current blockchain head
 // on the given channel.
+//
+// If the underlying client was created with rpc.WithAutoReconnect, the heads
+// missed while reconnecting are delivered after the subscription is resumed.
 func (ec *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
-	sub, err := ec.c.EthSubscribe(ctx, ch, "newHeads")
+	sub, err := ec.c.SubscribeResumable(ctx, "eth", newHeadResumer(), ch, "newHeads")
 	if err != nil {
 		// Defensively prefer returning nil interface explicitly on error-path, instead
 		// of letting default golang behavior wrap it with non-nil interface that stores
@@ -480,13 +484,38 @@ func (ec *Client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]typ
 	return result, err
 }
 
+// FilterLogsPage executes a filter query, returning a single page of at most
+// q.Limit logs starting at q.Cursor. The returned cursor continues the query with
+// the next page, it is empty if all matching logs have been returned.
+func (ec *Client) FilterLogsPage(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, string, error) {
+	var result struct {
+		Logs   []types.Log `json:"logs"`
+		Cursor string      `json:"cursor"`
+	}
+	arg, err := toFilterArg(q)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := ec.c.CallContext(ctx, &result, "eth_getLogsPage", arg); err != nil {
+		return nil, "", err
+	}
+	return result.Logs, result.Cursor, nil
+}
+
 // SubscribeFilterLogs subscribes to the results of a streaming filter query.
+//
+// If the underlying client was created with rpc.WithAutoReconnect, the logs
+// missed while reconnecting are delivered after the subscription is resumed.
 func (ec *Client) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
 	arg, err := toFilterArg(q)
 	if err != nil {
 		return nil, err
 	}
-	sub, err := ec.c.EthSubscribe(ctx, ch, "logs", arg)
+	var resumer rpc.SubscriptionResumer
+	if q.BlockHash == nil {
+		resumer = newLogResumer(arg.(map[string]interface{}))
+	}
+	sub, err := ec.c.SubscribeResumable(ctx, "eth", resumer, ch, "logs", arg)
 	if err != nil {
 		// Defensively prefer returning nil interface explicitly on error-path, instead
 		// of letting default golang behavior wrap it with non-nil interface that stores
@@ -497,27 +526,7 @@ func (ec *Client) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuer
 }
 
 func toFilterArg(q ethereum.FilterQuery) (interface{}, error) {
-	arg := map[string]interface{}{}
-	if q.Addresses != nil {
-		arg["address"] = q.Addresses
-	}
-	if q.Topics != nil {
-		arg["topics"] = q.Topics
-	}
-	if q.BlockHash != nil {
-		arg["blockHash"] = *q.BlockHash
-		if q.FromBlock != nil || q.ToBlock != nil {
-			return nil, errors.New("cannot specify both BlockHash and FromBlock/ToBlock")
-		}
-	} else {
-		if q.FromBlock == nil {
-			arg["fromBlock"] = "0x0"
-		} else {
-			arg["fromBlock"] = toBlockNumArg(q.FromBlock)
-		}
-		arg["toBlock"] = toBlockNumArg(q.ToBlock)
-	}
-	return arg, nil
+	return rpcargs.FilterArg(q)
 }
 
 // Pending State
@@ -762,18 +771,7 @@ func RevertErrorData(err error) ([]byte, bool) {
 }
 
 func toBlockNumArg(number *big.Int) string {
-	if number == nil {
-		return "latest"
-	}
-	if number.Sign() >= 0 {
-		return hexutil.EncodeBig(number)
-	}
-	// It's negative.
-	if number.IsInt64() {
-		return rpc.BlockNumber(number.Int64()).String()
-	}
-	// It's negative and large, which is invalid.
-	return fmt.Sprintf("<invalid %d>", number)
+	return rpcargs.BlockNumArg(number)
 }
 
 func toCallArg(msg ethereum.CallMsg) interface{} {
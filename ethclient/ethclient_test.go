@@ -36,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
@@ -110,6 +111,11 @@ func newTestBackend(config *node.Config) (*node.Node, []*types.Block, error) {
 	if err != nil {
 		return nil, nil, fmt.Errorf("can't create new ethereum service: %v", err)
 	}
+	filterSystem := filters.NewFilterSystem(ethservice.APIBackend, filters.Config{})
+	n.RegisterAPIs([]rpc.API{{
+		Namespace: "eth",
+		Service:   filters.NewFilterAPI(filterSystem),
+	}})
 	// Ensure tx pool starts the background operation
 	txPool := ethservice.TxPool()
 	if err = txPool.Sync(); err != nil {
@@ -189,6 +195,9 @@ func TestEthClient(t *testing.T) {
 		"TransactionSender": {
 			func(t *testing.T) { testTransactionSender(t, client) },
 		},
+		"FilterLogsPage": {
+			func(t *testing.T) { testFilterLogsPage(t, client) },
+		},
 	}
 
 	t.Parallel()
@@ -687,6 +696,29 @@ func testTransactionSender(t *testing.T, client *rpc.Client) {
 	}
 }
 
+func testFilterLogsPage(t *testing.T, client *rpc.Client) {
+	ec := ethclient.NewClient(client)
+	ctx := context.Background()
+
+	logs, cursor, err := ec.FilterLogsPage(ctx, ethereum.FilterQuery{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 0 || cursor != "" {
+		t.Fatalf("wrong log page: %d logs, cursor %q", len(logs), cursor)
+	}
+	// Cursor and limit are rejected by queries without pagination.
+	if _, err := ec.FilterLogs(ctx, ethereum.FilterQuery{Limit: 10}); err == nil {
+		t.Fatal("expected error for eth_getLogs with limit")
+	}
+	if _, err := ec.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Cursor: "0x00"}, make(chan types.Log)); err == nil {
+		t.Fatal("expected error for logs subscription with cursor")
+	}
+	if _, _, err := ec.FilterLogsPage(ctx, ethereum.FilterQuery{Cursor: "0x1234"}); err == nil {
+		t.Fatal("expected error for invalid cursor")
+	}
+}
+
 func TestBlockReceiptsPreservesCanonicalFlag(t *testing.T) {
 	srv := rpc.NewServer()
 	service := &blockReceiptsTestService{calls: make(chan rpc.BlockNumberOrHash, 1)}
@@ -0,0 +1,297 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package protoclient provides a client for the protobuf RPC transport of geth.
+package protoclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/internal/rpcargs"
+	"github.com/ethereum/go-ethereum/protorpc"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxResponseSize is the maximum size of a response body.
+const maxResponseSize = 128 * 1024 * 1024
+
+// Client sends method calls to the protobuf RPC endpoint of a node.
+type Client struct {
+	url    string
+	client *http.Client
+}
+
+// Dial creates a client for the given URL, e.g. "http://localhost:8545/protorpc".
+// Plain HTTP connections use HTTP/2 with prior knowledge, which requires the
+// server to support it. Use NewClient to talk to servers which don't.
+func Dial(rawurl string) (*Client, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Protocols = new(http.Protocols)
+	switch u.Scheme {
+	case "http":
+		transport.Protocols.SetUnencryptedHTTP2(true)
+	case "https":
+		transport.Protocols.SetHTTP1(true)
+		transport.Protocols.SetHTTP2(true)
+	default:
+		return nil, fmt.Errorf("no known transport for URL scheme %q", u.Scheme)
+	}
+	return NewClient(rawurl, &http.Client{Transport: transport}), nil
+}
+
+// NewClient creates a client sending its requests to the given URL through an
+// existing HTTP client.
+func NewClient(rawurl string, client *http.Client) *Client {
+	return &Client{url: rawurl, client: client}
+}
+
+// Close closes the idle connections of the client.
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
+// CallContext performs a method call returning a JSON encoded result, storing
+// the result into the value pointed to by result. If result is nil, the result
+// is discarded.
+func (c *Client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	res, err := c.call(ctx, method, args...)
+	if err != nil {
+		return err
+	}
+	switch res := res.Result.(type) {
+	case nil:
+		return nil
+	case *protorpc.Response_Json:
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(res.Json, result)
+	default:
+		return fmt.Errorf("method %s has a typed result", method)
+	}
+}
+
+// call sends a method call and returns the response. Failed calls are returned
+// as errors.
+func (c *Client) call(ctx context.Context, method string, args ...interface{}) (*protorpc.Response, error) {
+	req := &protorpc.Request{Method: method, Params: make([][]byte, len(args))}
+	for i, arg := range args {
+		param, err := json.Marshal(arg)
+		if err != nil {
+			return nil, err
+		}
+		req.Params[i] = param
+	}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	hreq.Header.Set("content-type", protorpc.ContentType)
+
+	hres, err := c.client.Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	defer hres.Body.Close()
+
+	body, err = io.ReadAll(io.LimitReader(hres.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if hres.StatusCode != http.StatusOK {
+		return nil, rpc.HTTPError{StatusCode: hres.StatusCode, Status: hres.Status, Body: body}
+	}
+	res := new(protorpc.Response)
+	if err := proto.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	if failure := res.GetError(); failure != nil {
+		return nil, &Error{Code: int(failure.Code), Message: failure.Message, Data: failure.Data}
+	}
+	return res, nil
+}
+
+// Error is a failed method call. It implements the rpc.Error and rpc.DataError
+// interfaces.
+type Error struct {
+	Code    int
+	Message string
+	Data    json.RawMessage // JSON encoded error data, if any
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("protorpc error %d", e.Code)
+	}
+	return e.Message
+}
+
+// ErrorCode returns the RPC error code.
+func (e *Error) ErrorCode() int {
+	return e.Code
+}
+
+// ErrorData returns the error data, or nil if there is none.
+func (e *Error) ErrorData() interface{} {
+	if e.Data == nil {
+		return nil
+	}
+	return e.Data
+}
+
+// BlockNumber returns the most recent block number.
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	var result hexutil.Uint64
+	err := c.CallContext(ctx, &result, "eth_blockNumber")
+	return uint64(result), err
+}
+
+// HeaderByHash returns the block header with the given hash.
+func (c *Client) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return c.header(ctx, "eth_getHeaderByHash", hash)
+}
+
+// HeaderByNumber returns a block header from the current canonical chain. If number
+// is nil, the latest known header is returned.
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return c.header(ctx, "eth_getHeaderByNumber", rpcargs.BlockNumArg(number))
+}
+
+func (c *Client) header(ctx context.Context, method string, args ...interface{}) (*types.Header, error) {
+	res, err := c.call(ctx, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result == nil {
+		return nil, ethereum.NotFound
+	}
+	return protorpc.DecodeHeader(res.GetHeader())
+}
+
+// BlockByHash returns the given full block.
+func (c *Client) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return c.block(ctx, "eth_getBlockByHash", hash, true)
+}
+
+// BlockByNumber returns a block from the current canonical chain. If number is
+// nil, the latest known block is returned.
+func (c *Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return c.block(ctx, "eth_getBlockByNumber", rpcargs.BlockNumArg(number), true)
+}
+
+func (c *Client) block(ctx context.Context, method string, args ...interface{}) (*types.Block, error) {
+	res, err := c.call(ctx, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result == nil {
+		return nil, ethereum.NotFound
+	}
+	return protorpc.DecodeBlock(res.GetBlock())
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+// Note that the receipt is not available for pending transactions.
+func (c *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	res, err := c.call(ctx, "eth_getTransactionReceipt", txHash)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result == nil {
+		return nil, ethereum.NotFound
+	}
+	return protorpc.DecodeReceipt(res.GetReceipt())
+}
+
+// BlockReceipts returns the receipts of a given block number or hash.
+func (c *Client) BlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*types.Receipt, error) {
+	res, err := c.call(ctx, "eth_getBlockReceipts", blockNrOrHash.String())
+	if err != nil {
+		return nil, err
+	}
+	if res.Result == nil {
+		return nil, ethereum.NotFound
+	}
+	enc := res.GetReceipts()
+	if enc == nil {
+		return nil, errors.New("unexpected result type for block receipts")
+	}
+	receipts := make([]*types.Receipt, len(enc.Receipts))
+	for i, r := range enc.Receipts {
+		if receipts[i], err = protorpc.DecodeReceipt(r); err != nil {
+			return nil, err
+		}
+	}
+	return receipts, nil
+}
+
+// FilterLogs executes a filter query.
+func (c *Client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	logs, _, err := c.filterLogs(ctx, "eth_getLogs", q)
+	return logs, err
+}
+
+// FilterLogsPage executes a filter query, returning a single page of at most
+// q.Limit logs starting at q.Cursor. The returned cursor continues the query with
+// the next page, it is empty if all matching logs have been returned.
+func (c *Client) FilterLogsPage(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, string, error) {
+	return c.filterLogs(ctx, "eth_getLogsPage", q)
+}
+
+func (c *Client) filterLogs(ctx context.Context, method string, q ethereum.FilterQuery) ([]types.Log, string, error) {
+	arg, err := rpcargs.FilterArg(q)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := c.call(ctx, method, arg)
+	if err != nil {
+		return nil, "", err
+	}
+	enc := res.GetLogs()
+	if enc == nil && res.Result != nil {
+		return nil, "", errors.New("unexpected result type for logs")
+	}
+	decoded, err := protorpc.DecodeLogs(enc)
+	if err != nil {
+		return nil, "", err
+	}
+	logs := make([]types.Log, len(decoded))
+	for i, l := range decoded {
+		logs[i] = *l
+	}
+	return logs, enc.GetCursor(), nil
+}
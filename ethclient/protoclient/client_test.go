@@ -0,0 +1,255 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package protoclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/protorpc"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// testServer serves canned protobuf RPC responses, recording the requests.
+type testServer struct {
+	*httptest.Server
+	t         *testing.T
+	requests  []*protorpc.Request
+	responses map[string]*protorpc.Response
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{t: t, responses: make(map[string]*protorpc.Response)}
+	s.Server = httptest.NewUnstartedServer(s)
+	s.Server.Config.Protocols = new(http.Protocols)
+	s.Server.Config.Protocols.SetHTTP1(true)
+	s.Server.Config.Protocols.SetUnencryptedHTTP2(true)
+	s.Start()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("content-type") != protorpc.ContentType {
+		http.Error(w, "invalid content type", http.StatusUnsupportedMediaType)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	req := new(protorpc.Request)
+	if err := proto.Unmarshal(body, req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, req)
+
+	res, ok := s.responses[req.Method]
+	if !ok {
+		res = &protorpc.Response{Result: &protorpc.Response_Error{Error: &protorpc.Error{Code: -32601, Message: "method not found"}}}
+	}
+	out, err := proto.Marshal(res)
+	if err != nil {
+		s.t.Error(err)
+	}
+	w.Header().Set("content-type", protorpc.ContentType)
+	w.Write(out)
+}
+
+// lastParams returns the JSON encoded parameters of the last request.
+func (s *testServer) lastParams() []string {
+	req := s.requests[len(s.requests)-1]
+	params := make([]string, len(req.Params))
+	for i, param := range req.Params {
+		params[i] = string(param)
+	}
+	return params
+}
+
+func newTestBlock() *types.Block {
+	header := &types.Header{
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(10),
+		GasLimit:   30_000_000,
+		Time:       1700000000,
+		Extra:      []byte{},
+		BaseFee:    big.NewInt(7),
+	}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &common.Address{0x01},
+		Value:     big.NewInt(3),
+	})
+	return types.NewBlockWithHeader(header).WithBody(types.Body{Transactions: types.Transactions{tx}})
+}
+
+func TestClientRoundTrip(t *testing.T) {
+	var (
+		srv   = newTestServer(t)
+		ctx   = context.Background()
+		block = newTestBlock()
+	)
+	client, err := Dial(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// JSON results are decoded into the result value.
+	srv.responses["eth_blockNumber"] = &protorpc.Response{Result: &protorpc.Response_Json{Json: []byte(`"0xa"`)}}
+	number, err := client.BlockNumber(ctx)
+	if err != nil {
+		t.Fatalf("failed to retrieve block number: %v", err)
+	}
+	if number != 10 {
+		t.Fatalf("block number mismatch: have %d, want %d", number, 10)
+	}
+
+	// Typed results are decoded from the protobuf messages.
+	encBlock, err := protorpc.EncodeBlock(block, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.responses["eth_getBlockByNumber"] = &protorpc.Response{Result: &protorpc.Response_Block{Block: encBlock}}
+	have, err := client.BlockByNumber(ctx, big.NewInt(10))
+	if err != nil {
+		t.Fatalf("failed to retrieve block: %v", err)
+	}
+	if have.Hash() != block.Hash() || have.Transactions()[0].Hash() != block.Transactions()[0].Hash() {
+		t.Fatal("block mismatch")
+	}
+	if params := srv.lastParams(); !reflect.DeepEqual(params, []string{`"0xa"`, `true`}) {
+		t.Fatalf("block request params mismatch: %v", params)
+	}
+	srv.responses["eth_getHeaderByHash"] = &protorpc.Response{Result: &protorpc.Response_Header{Header: protorpc.EncodeHeader(block.Header())}}
+	header, err := client.HeaderByHash(ctx, block.Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve header: %v", err)
+	}
+	if header.Hash() != block.Hash() {
+		t.Fatal("header mismatch")
+	}
+
+	receipt := &types.Receipt{
+		Type:              types.DynamicFeeTxType,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs:              []*types.Log{},
+		TxHash:            block.Transactions()[0].Hash(),
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(2),
+		BlockHash:         block.Hash(),
+		BlockNumber:       block.Number(),
+	}
+	srv.responses["eth_getTransactionReceipt"] = &protorpc.Response{Result: &protorpc.Response_Receipt{Receipt: protorpc.EncodeReceipt(receipt)}}
+	haveReceipt, err := client.TransactionReceipt(ctx, receipt.TxHash)
+	if err != nil {
+		t.Fatalf("failed to retrieve receipt: %v", err)
+	}
+	if !reflect.DeepEqual(haveReceipt, receipt) {
+		t.Fatalf("receipt mismatch:\nhave %+v\nwant %+v", haveReceipt, receipt)
+	}
+
+	// Filter queries are encoded like the JSON-RPC client does, including the
+	// pagination fields.
+	log := &types.Log{
+		Address:     common.Address{0x02},
+		Topics:      []common.Hash{{0x03}},
+		Data:        []byte{0x04},
+		BlockNumber: 10,
+		BlockHash:   block.Hash(),
+	}
+	srv.responses["eth_getLogsPage"] = &protorpc.Response{Result: &protorpc.Response_Logs{Logs: protorpc.EncodeLogs([]*types.Log{log}, "next")}}
+	logs, cursor, err := client.FilterLogsPage(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(1),
+		Addresses: []common.Address{log.Address},
+		Cursor:    "prev",
+		Limit:     1,
+	})
+	if err != nil {
+		t.Fatalf("failed to retrieve log page: %v", err)
+	}
+	if len(logs) != 1 || !reflect.DeepEqual(&logs[0], log) || cursor != "next" {
+		t.Fatalf("log page mismatch: %v, cursor %q", logs, cursor)
+	}
+	var crit map[string]interface{}
+	if err := json.Unmarshal([]byte(srv.lastParams()[0]), &crit); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"address":   []interface{}{hexutil.Encode(log.Address.Bytes())},
+		"fromBlock": "0x1",
+		"toBlock":   "latest",
+		"cursor":    "prev",
+		"limit":     "0x1",
+	}
+	if !reflect.DeepEqual(crit, want) {
+		t.Fatalf("filter criteria mismatch:\nhave %v\nwant %v", crit, want)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	var (
+		srv = newTestServer(t)
+		ctx = context.Background()
+	)
+	client := NewClient(srv.URL, http.DefaultClient)
+	defer client.Close()
+
+	// Empty results of typed methods stand for missing objects.
+	srv.responses["eth_getBlockByHash"] = new(protorpc.Response)
+	if _, err := client.BlockByHash(ctx, common.Hash{0x01}); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	// Failed calls retain the code and data of the error.
+	srv.responses["eth_call"] = &protorpc.Response{Result: &protorpc.Response_Error{Error: &protorpc.Error{
+		Code:    3,
+		Message: "execution reverted",
+		Data:    []byte(`"0x01"`),
+	}}}
+	err := client.CallContext(ctx, nil, "eth_call", map[string]interface{}{}, "latest")
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) || err.(rpc.Error).ErrorCode() != 3 || string(dataErr.ErrorData().(json.RawMessage)) != `"0x01"` {
+		t.Fatalf("wrong error: %v", err)
+	}
+	// Typed results can't be retrieved as JSON.
+	srv.responses["eth_getHeaderByNumber"] = &protorpc.Response{Result: &protorpc.Response_Header{Header: protorpc.EncodeHeader(newTestBlock().Header())}}
+	var header json.RawMessage
+	if err := client.CallContext(ctx, &header, "eth_getHeaderByNumber", "latest"); err == nil {
+		t.Fatal("expected error retrieving typed result as JSON")
+	}
+	// HTTP errors are reported as such.
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+	client = NewClient(missing.URL, http.DefaultClient)
+	if _, err := client.BlockNumber(ctx); !errors.As(err, new(rpc.HTTPError)) {
+		t.Fatalf("expected HTTP error, got %v", err)
+	}
+}
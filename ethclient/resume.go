@@ -0,0 +1,204 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethclient
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// maxResumeBlocks is the maximum number of blocks backfilled when resuming a
+	// subscription after a reconnect. Longer outages leave a gap in the stream.
+	maxResumeBlocks = 1024
+
+	// resumeBatchSize is the number of blocks requested in a single batch while
+	// backfilling heads.
+	resumeBatchSize = 64
+
+	// resumeDedupLimit is the number of delivered notifications remembered to
+	// drop duplicates received after a reconnect.
+	resumeDedupLimit = 4096
+)
+
+// resumeRange returns the range of blocks to backfill starting at the given one,
+// or false if there's nothing to backfill.
+func resumeRange(ctx context.Context, c *rpc.Client, from uint64) (uint64, uint64, bool, error) {
+	var head hexutil.Uint64
+	if err := c.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return 0, 0, false, err
+	}
+	if uint64(head) < from {
+		return 0, 0, false, nil
+	}
+	if uint64(head)-from >= maxResumeBlocks {
+		log.Warn("Subscription gap too large to backfill", "from", from, "head", uint64(head), "limit", maxResumeBlocks)
+		from = uint64(head) - maxResumeBlocks + 1
+	}
+	return from, uint64(head), true, nil
+}
+
+// headResumer backfills the heads missed by a newHeads subscription through
+// eth_getBlockByNumber.
+type headResumer struct {
+	last uint64                              // Number of the last delivered head
+	seen lru.BasicLRU[common.Hash, struct{}] // Hashes of the recently delivered heads
+}
+
+func newHeadResumer() *headResumer {
+	return &headResumer{seen: lru.NewBasicLRU[common.Hash, struct{}](resumeDedupLimit)}
+}
+
+func (r *headResumer) Init(ctx context.Context, c *rpc.Client) error {
+	var head hexutil.Uint64
+	if err := c.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return err
+	}
+	r.last = uint64(head)
+	return nil
+}
+
+func (r *headResumer) Filter(result json.RawMessage) bool {
+	var head struct {
+		Number hexutil.Uint64 `json:"number"`
+		Hash   common.Hash    `json:"hash"`
+	}
+	if err := json.Unmarshal(result, &head); err != nil {
+		return true // let the subscription report the error
+	}
+	if r.seen.Contains(head.Hash) {
+		return false
+	}
+	r.seen.Add(head.Hash, struct{}{})
+	r.last = uint64(head.Number)
+	return true
+}
+
+func (r *headResumer) Resume(ctx context.Context, c *rpc.Client) ([]json.RawMessage, error) {
+	from, to, ok, err := resumeRange(ctx, c, r.last+1)
+	if !ok || err != nil {
+		return nil, err
+	}
+	var missed []json.RawMessage
+	for start := from; start <= to; start += resumeBatchSize {
+		var (
+			end   = min(start+resumeBatchSize-1, to)
+			batch = make([]rpc.BatchElem, 0, end-start+1)
+		)
+		for number := start; number <= end; number++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.Uint64(number), false},
+				Result: new(json.RawMessage),
+			})
+		}
+		if err := c.BatchCallContext(ctx, batch); err != nil {
+			return nil, err
+		}
+		for _, elem := range batch {
+			if elem.Error != nil {
+				return nil, elem.Error
+			}
+			if head := *elem.Result.(*json.RawMessage); len(head) > 0 && string(head) != "null" {
+				missed = append(missed, head)
+			}
+		}
+	}
+	return missed, nil
+}
+
+// logKey identifies a delivered log.
+type logKey struct {
+	block   common.Hash
+	index   uint
+	removed bool
+}
+
+// logResumer backfills the logs missed by a logs subscription through
+// eth_getLogs.
+//
+// As the subscription doesn't deliver heads, the resumer tracks the last head it
+// saw when subscribing or resuming, advanced by the blocks of the delivered logs.
+// Blocks up to that head without matching logs are never requested again.
+type logResumer struct {
+	query map[string]interface{}         // Filter criteria of the subscription
+	last  uint64                         // Number of the last seen head block
+	seen  lru.BasicLRU[logKey, struct{}] // Recently delivered logs
+}
+
+func newLogResumer(query map[string]interface{}) *logResumer {
+	return &logResumer{
+		query: query,
+		seen:  lru.NewBasicLRU[logKey, struct{}](resumeDedupLimit),
+	}
+}
+
+func (r *logResumer) Init(ctx context.Context, c *rpc.Client) error {
+	var head hexutil.Uint64
+	if err := c.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return err
+	}
+	r.last = uint64(head)
+	return nil
+}
+
+func (r *logResumer) Filter(result json.RawMessage) bool {
+	var entry struct {
+		BlockNumber hexutil.Uint64 `json:"blockNumber"`
+		BlockHash   common.Hash    `json:"blockHash"`
+		Index       hexutil.Uint   `json:"logIndex"`
+		Removed     bool           `json:"removed"`
+	}
+	if err := json.Unmarshal(result, &entry); err != nil {
+		return true // let the subscription report the error
+	}
+	key := logKey{block: entry.BlockHash, index: uint(entry.Index), removed: entry.Removed}
+	if r.seen.Contains(key) {
+		return false
+	}
+	r.seen.Add(key, struct{}{})
+	if !entry.Removed {
+		r.last = max(r.last, uint64(entry.BlockNumber))
+	}
+	return true
+}
+
+func (r *logResumer) Resume(ctx context.Context, c *rpc.Client) ([]json.RawMessage, error) {
+	// Start at the last seen head, as the connection may have dropped before all
+	// of its logs were delivered. Duplicates are filtered.
+	from, to, ok, err := resumeRange(ctx, c, r.last)
+	if !ok || err != nil {
+		return nil, err
+	}
+	query := maps.Clone(r.query)
+	query["fromBlock"] = hexutil.Uint64(from)
+	query["toBlock"] = hexutil.Uint64(to)
+
+	var missed []json.RawMessage
+	if err := c.CallContext(ctx, &missed, "eth_getLogs", query); err != nil {
+		return nil, err
+	}
+	r.last = to
+	return missed, nil
+}
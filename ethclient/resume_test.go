@@ -0,0 +1,178 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethclient
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// resumeTestService serves the chain data needed to resume subscriptions.
+type resumeTestService struct {
+	head  atomic.Uint64
+	heads []*types.Header
+	logs  []*types.Log
+}
+
+func (s *resumeTestService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.head.Load())
+}
+
+func (s *resumeTestService) GetBlockByNumber(number hexutil.Uint64, full bool) *types.Header {
+	return s.heads[number]
+}
+
+func (s *resumeTestService) GetLogs(crit struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+}) []*types.Log {
+	var logs []*types.Log
+	for _, log := range s.logs {
+		if log.BlockNumber >= uint64(crit.FromBlock) && log.BlockNumber <= uint64(crit.ToBlock) {
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func newResumeTestClient(t *testing.T) (*rpc.Client, *resumeTestService) {
+	service := new(resumeTestService)
+	for i := 0; i < 10; i++ {
+		service.heads = append(service.heads, &types.Header{Number: big.NewInt(int64(i)), Difficulty: common.Big0})
+	}
+	for _, pos := range [][2]uint{{2, 0}, {4, 0}, {4, 1}, {6, 0}} {
+		service.logs = append(service.logs, &types.Log{
+			BlockNumber: uint64(pos[0]),
+			BlockHash:   service.heads[pos[0]].Hash(),
+			Index:       pos[1],
+			Topics:      []common.Hash{},
+			Data:        []byte{},
+		})
+	}
+	service.head.Store(3)
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return rpc.DialInProc(server), service
+}
+
+func mustEncode(t *testing.T, v interface{}) json.RawMessage {
+	enc, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enc
+}
+
+// Tests that the heads missed by a newHeads subscription are backfilled and the
+// ones delivered twice are dropped.
+func TestHeadResumer(t *testing.T) {
+	client, service := newResumeTestClient(t)
+	defer client.Close()
+
+	r := newHeadResumer()
+	if err := r.Init(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Filter(mustEncode(t, service.heads[4])) {
+		t.Fatal("new head filtered")
+	}
+	if r.Filter(mustEncode(t, service.heads[4])) {
+		t.Fatal("duplicate head not filtered")
+	}
+	service.head.Store(8)
+
+	missed, err := r.Resume(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missed) != 4 {
+		t.Fatalf("missed head count mismatch: have %d, want %d", len(missed), 4)
+	}
+	for i, result := range missed {
+		var head types.Header
+		if err := json.Unmarshal(result, &head); err != nil {
+			t.Fatal(err)
+		}
+		if head.Hash() != service.heads[5+i].Hash() {
+			t.Errorf("missed head %d mismatch", i)
+		}
+		if !r.Filter(result) {
+			t.Errorf("missed head %d filtered", i)
+		}
+	}
+	if r.Filter(mustEncode(t, service.heads[8])) {
+		t.Fatal("backfilled head not filtered")
+	}
+}
+
+// Tests that the logs missed by a logs subscription are backfilled, including
+// the remaining logs of the last delivered block, and that duplicates are dropped.
+func TestLogResumer(t *testing.T) {
+	client, service := newResumeTestClient(t)
+	defer client.Close()
+
+	r := newLogResumer(map[string]interface{}{})
+	if err := r.Init(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Filter(mustEncode(t, service.logs[1])) {
+		t.Fatal("new log filtered")
+	}
+	service.head.Store(7)
+
+	missed, err := r.Resume(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var delivered []uint64
+	for _, result := range missed {
+		if r.Filter(result) {
+			var log types.Log
+			if err := json.Unmarshal(result, &log); err != nil {
+				t.Fatal(err)
+			}
+			delivered = append(delivered, log.BlockNumber*10+uint64(log.Index))
+		}
+	}
+	if len(delivered) != 2 || delivered[0] != 41 || delivered[1] != 60 {
+		t.Fatalf("delivered logs mismatch: %v", delivered)
+	}
+	// The next resume starts at the head seen by the previous one, even though
+	// no log was delivered since.
+	if r.last != 7 {
+		t.Fatalf("last seen head mismatch: have %d, want %d", r.last, 7)
+	}
+	service.head.Store(9)
+	if _, err := r.Resume(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if r.last != 9 {
+		t.Fatalf("last seen head mismatch: have %d, want %d", r.last, 9)
+	}
+}
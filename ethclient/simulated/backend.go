@@ -71,7 +71,20 @@ type Backend struct {
 // contract bindings in unit tests.
 //
 // A simulated backend always uses chainID 1337.
+//
+// NewBackend panics if the backend can't be created, which may only happen if it
+// is forked off a remote chain. Use New to handle the error instead.
 func NewBackend(alloc types.GenesisAlloc, options ...func(nodeConf *node.Config, ethConf *ethconfig.Config)) *Backend {
+	sim, err := New(alloc, options...)
+	if err != nil {
+		panic(err)
+	}
+	return sim
+}
+
+// New creates a new simulated blockchain like NewBackend, returning an error if
+// the remote chain configured with WithFork can't be reached.
+func New(alloc types.GenesisAlloc, options ...func(nodeConf *node.Config, ethConf *ethconfig.Config)) (*Backend, error) {
 	// Create the default configurations for the outer node shell and the Ethereum
 	// service to mutate with the options afterwards
 	nodeConf := node.DefaultConfig
@@ -90,16 +103,27 @@ func NewBackend(alloc types.GenesisAlloc, options ...func(nodeConf *node.Config,
 	for _, option := range options {
 		option(&nodeConf, &ethConf)
 	}
+	// If forking off a remote chain, retrieve the forked block and start from its
+	// parameters. The options are reapplied to take precedence over the remote.
+	if source, ok := ethConf.StateFork.(*rpcForkSource); ok {
+		if err := source.init(ethConf.Genesis, &ethConf.Miner); err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			option(&nodeConf, &ethConf)
+		}
+	}
 	// Assemble the Ethereum stack to run the chain with
 	stack, err := node.New(&nodeConf)
 	if err != nil {
-		panic(err) // this should never happen
+		return nil, err
 	}
 	sim, err := newWithNode(stack, &ethConf, 0)
 	if err != nil {
-		panic(err) // this should never happen
+		stack.Close()
+		return nil, err
 	}
-	return sim
+	return sim, nil
 }
 
 // newWithNode sets up a simulated backend on an existing node. The provided node
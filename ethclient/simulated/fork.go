@@ -0,0 +1,192 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+)
+
+// WithFork configures the simulated backend to fork off the state of a remote
+// chain at the given block, or at the latest one if number is nil. Accounts,
+// contract code and storage are fetched lazily from the remote node and cached,
+// the local changes are kept on top without ever touching the remote chain.
+//
+// The genesis block of the simulated chain inherits the timestamp, gas limit and
+// base fee of the forked block, the allocation passed to NewBackend overrides
+// the remote accounts. Note, the simulated chain still starts at block zero, so
+// the block number and the hashes of the remote blocks are not available to the
+// executed contracts.
+//
+// The remote chain is only contacted when the backend is created, use New to
+// handle the failure to reach it.
+func WithFork(client *rpc.Client, number *big.Int) func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+	source := newRPCForkSource(client, number)
+
+	return func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		// Forking requires the trie to be the single source of the local state
+		ethConf.StateScheme = rawdb.HashScheme
+		ethConf.SnapshotCache = 0
+		ethConf.StateFork = source
+	}
+}
+
+// rpcForkSource implements state.ForkSource, retrieving the state of a remote
+// chain at a fixed block over RPC. All retrieved data is cached, as the state
+// of the block can't change.
+type rpcForkSource struct {
+	eth    *ethclient.Client
+	geth   *gethclient.Client
+	number *big.Int
+
+	accounts map[common.Address]*types.StateAccount
+	storage  map[common.Address]map[common.Hash]common.Hash
+	empty    map[common.Address]bool // Accounts known to have no storage
+	code     map[common.Address][]byte
+	lock     sync.Mutex
+}
+
+// newRPCForkSource creates a fork source of the remote state at the given block,
+// or at the latest one if number is nil. The source must be initialized before
+// use.
+func newRPCForkSource(client *rpc.Client, number *big.Int) *rpcForkSource {
+	source := &rpcForkSource{
+		eth:      ethclient.NewClient(client),
+		geth:     gethclient.New(client),
+		accounts: make(map[common.Address]*types.StateAccount),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+		empty:    make(map[common.Address]bool),
+		code:     make(map[common.Address][]byte),
+	}
+	if number != nil {
+		source.number = new(big.Int).Set(number)
+	}
+	return source
+}
+
+// init retrieves the forked block from the remote chain, pinning the source to
+// it and configuring the local genesis block and miner to continue from it.
+func (s *rpcForkSource) init(genesis *core.Genesis, miner *miner.Config) error {
+	head, err := s.eth.HeaderByNumber(context.Background(), s.number)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve forked block: %w", err)
+	}
+	s.number = new(big.Int).Set(head.Number)
+
+	genesis.Timestamp = head.Time
+	genesis.GasLimit = head.GasLimit
+	genesis.BaseFee = head.BaseFee
+	miner.GasCeil = head.GasLimit
+	return nil
+}
+
+// Account implements state.ForkSource, retrieving the remote account with
+// eth_getProof.
+func (s *rpcForkSource) Account(addr common.Address) (*types.StateAccount, error) {
+	s.lock.Lock()
+	account, ok := s.accounts[addr]
+	s.lock.Unlock()
+
+	if !ok {
+		res, err := s.geth.GetProof(context.Background(), addr, nil, s.number)
+		if err != nil {
+			return nil, err
+		}
+		codeHash := res.CodeHash
+		if codeHash == (common.Hash{}) {
+			codeHash = types.EmptyCodeHash
+		}
+		if res.Nonce != 0 || res.Balance.Sign() != 0 || codeHash != types.EmptyCodeHash {
+			account = &types.StateAccount{
+				Nonce:    res.Nonce,
+				Balance:  uint256.MustFromBig(res.Balance),
+				Root:     res.StorageHash,
+				CodeHash: codeHash.Bytes(),
+			}
+		}
+		s.lock.Lock()
+		s.accounts[addr] = account
+		s.empty[addr] = account == nil || account.Root == types.EmptyRootHash || account.Root == (common.Hash{})
+		s.lock.Unlock()
+	}
+	if account == nil {
+		return nil, nil
+	}
+	return account.Copy(), nil
+}
+
+// Storage implements state.ForkSource, retrieving the remote storage slot with
+// eth_getStorageAt.
+func (s *rpcForkSource) Storage(addr common.Address, slot common.Hash) (common.Hash, error) {
+	s.lock.Lock()
+	value, ok := s.storage[addr][slot]
+	empty := s.empty[addr]
+	s.lock.Unlock()
+
+	if ok || empty {
+		return value, nil
+	}
+	blob, err := s.eth.StorageAt(context.Background(), addr, slot, s.number)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	value = common.BytesToHash(blob)
+
+	s.lock.Lock()
+	if s.storage[addr] == nil {
+		s.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.storage[addr][slot] = value
+	s.lock.Unlock()
+
+	return value, nil
+}
+
+// Code implements state.ForkSource, retrieving the remote contract code with
+// eth_getCode.
+func (s *rpcForkSource) Code(addr common.Address) ([]byte, error) {
+	s.lock.Lock()
+	code, ok := s.code[addr]
+	s.lock.Unlock()
+
+	if ok {
+		return code, nil
+	}
+	code, err := s.eth.CodeAt(context.Background(), addr, s.number)
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	s.code[addr] = code
+	s.lock.Unlock()
+
+	return code, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that a simulated backend can fork off the state of a remote chain, with
+// the local changes kept separate from the remote state.
+func TestWithFork(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		contract = common.HexToAddress("0xc0de")
+		balance  = big.NewInt(10000000000000000)
+	)
+	// Create the remote chain with a contract clearing its first storage slot
+	remote := NewBackend(types.GenesisAlloc{
+		testAddr: {Balance: balance},
+		contract: {
+			Code: []byte{0x60, 0x00, 0x60, 0x00, 0x55, 0x00}, // PUSH1 0 PUSH1 0 SSTORE STOP
+			Storage: map[common.Hash]common.Hash{
+				{}:                    common.HexToHash("0x2a"),
+				common.HexToHash("1"): common.HexToHash("0x2b"),
+			},
+		},
+	})
+	defer remote.Close()
+	remote.Commit()
+
+	rpcClient := remote.node.Attach()
+	defer rpcClient.Close()
+
+	head, err := remote.Client().HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim, err := New(types.GenesisAlloc{testAddr2: {Balance: balance}}, WithFork(rpcClient, nil))
+	if err != nil {
+		t.Fatalf("failed to fork remote chain: %v", err)
+	}
+	defer sim.Close()
+	client := sim.Client()
+
+	genesis, err := client.HeaderByNumber(ctx, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis.Time != head.Time || genesis.BaseFee.Cmp(head.BaseFee) != 0 {
+		t.Errorf("genesis mismatch: have time %d basefee %v, want %d %v", genesis.Time, genesis.BaseFee, head.Time, head.BaseFee)
+	}
+	// The remote state is visible alongside the local allocation.
+	if have, _ := client.BalanceAt(ctx, testAddr, nil); have.Cmp(balance) != 0 {
+		t.Errorf("remote balance mismatch: have %v, want %v", have, balance)
+	}
+	if have, _ := client.BalanceAt(ctx, testAddr2, nil); have.Cmp(balance) != 0 {
+		t.Errorf("local balance mismatch: have %v, want %v", have, balance)
+	}
+	if code, _ := client.CodeAt(ctx, contract, nil); len(code) != 6 {
+		t.Errorf("remote code mismatch: have %x", code)
+	}
+	if value, _ := client.StorageAt(ctx, contract, common.Hash{}, nil); common.BytesToHash(value) != common.HexToHash("0x2a") {
+		t.Errorf("remote slot mismatch: have %x", value)
+	}
+
+	// Execute the contract from the remote account, clearing the remote slot.
+	chainID, _ := client.ChainID(ctx)
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: new(big.Int).Add(head.BaseFee, big.NewInt(params.GWei)),
+		Gas:       100000,
+		To:        &contract,
+	}), types.LatestSignerForChainID(chainID), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	sim.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("contract execution failed")
+	}
+	if value, _ := client.StorageAt(ctx, contract, common.Hash{}, nil); common.BytesToHash(value) != (common.Hash{}) {
+		t.Errorf("cleared slot still set: %x", value)
+	}
+	if value, _ := client.StorageAt(ctx, contract, common.HexToHash("1"), nil); common.BytesToHash(value) != common.HexToHash("0x2b") {
+		t.Errorf("untouched slot mismatch: have %x", value)
+	}
+	if value, _ := remote.Client().StorageAt(ctx, contract, common.Hash{}, nil); common.BytesToHash(value) != common.HexToHash("0x2a") {
+		t.Errorf("remote slot modified: %x", value)
+	}
+
+	// Rolled back transactions are discarded as usual.
+	tx, err = newTx(sim, testKey, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	sim.Rollback()
+	if err := sim.AdjustTime(time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, _ := client.NonceAt(ctx, testAddr, nil); nonce != 1 {
+		t.Errorf("nonce mismatch after rollback: have %d, want 1", nonce)
+	}
+}
+
+// Tests that an unreachable remote chain is reported when creating the backend.
+func TestWithForkUnreachable(t *testing.T) {
+	remote := NewBackend(nil)
+	rpcClient := remote.node.Attach()
+	remote.Close()
+
+	if _, err := New(nil, WithFork(rpcClient, nil)); err == nil {
+		t.Fatal("expected error forking an unreachable chain")
+	}
+}
@@ -19,6 +19,7 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -665,6 +666,9 @@ type Block struct {
 	header   *types.Header
 	block    *types.Block
 	receipts []*types.Receipt
+
+	traceMu sync.Mutex                   // Serializes tracing of the block
+	traces  map[string][]json.RawMessage // Cached tracer results of the transactions
 }
 
 // resolve returns the internal Block object representing this block, fetching
@@ -1285,6 +1289,10 @@ func (p *Pending) EstimateGas(ctx context.Context, args struct {
 type Resolver struct {
 	backend      ethapi.Backend
 	filterSystem *filters.FilterSystem
+	tracing      bool // Whether the transaction tracing fields are enabled
+
+	eventsOnce sync.Once
+	events     *filters.EventSystem // Event source of the subscriptions, created on first use
 }
 
 func (r *Resolver) Block(ctx context.Context, args struct {
@@ -55,7 +55,7 @@ func TestBuildSchema(t *testing.T) {
 	}
 	defer stack.Close()
 	// Make sure the schema can be parsed and matched up to the object model.
-	if _, err := newHandler(stack, nil, nil, []string{}, []string{}); err != nil {
+	if _, err := newHandler(stack, nil, nil, []string{}, []string{}, false); err != nil {
 		t.Errorf("Could not construct GraphQL handler: %v", err)
 	}
 }
@@ -430,13 +430,92 @@ func TestWithdrawals(t *testing.T) {
 	}
 }
 
+func TestGraphQLTransactionTraces(t *testing.T) {
+	var (
+		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		caller = common.HexToAddress("0xaa")
+		callee = common.HexToAddress("0xbb")
+
+		genesis = &core.Genesis{
+			Config:     params.AllEthashProtocolChanges,
+			GasLimit:   11500000,
+			Difficulty: common.Big1,
+			Alloc: types.GenesisAlloc{
+				addr: {Balance: big.NewInt(params.Ether)},
+				// The address 0xaa calls 0xbb, then stores 0x01 at slot 0x00
+				caller: {
+					Code: []byte{
+						byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+						byte(vm.PUSH1), 0xbb, byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+						byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+					},
+				},
+				// The address 0xbb stops immediately
+				callee: {Code: []byte{byte(vm.STOP)}},
+			},
+		}
+		signer = types.LatestSigner(genesis.Config)
+		stack  = createNode(t)
+	)
+	defer stack.Close()
+
+	handler, _ := newGQLService(t, stack, true, genesis, 1, func(i int, gen *core.BlockGen) {
+		tx, _ := types.SignNewTx(key, signer, &types.LegacyTx{To: &caller, Gas: 100000, GasPrice: big.NewInt(params.InitialBaseFee)})
+		gen.AddTx(tx)
+	})
+	// start node
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+
+	for i, tt := range []struct {
+		body string
+		want string
+	}{
+		{
+			body: "{block(number: 1) { transactions { callFrames { type depth from to value input calls { to } } } } }",
+			want: `{"block":{"transactions":[{"callFrames":[{"type":"CALL","depth":"0x0","from":"0x71562b71999873db5b286df957af199ec94617f7","to":"0x00000000000000000000000000000000000000aa","value":"0x0","input":"0x","calls":[{"to":"0x00000000000000000000000000000000000000bb"}]},{"type":"CALL","depth":"0x1","from":"0x00000000000000000000000000000000000000aa","to":"0x00000000000000000000000000000000000000bb","value":"0x0","input":"0x","calls":[]}]}]}}`,
+		},
+		{
+			body: "{block(number: 1) { transactions { stateDiff { address pre { balance nonce code storage { key value } } post { balance nonce code storage { key value } } } } } }",
+			want: `{"block":{"transactions":[{"stateDiff":[{"address":"0x0000000000000000000000000000000000000000","pre":null,"post":{"balance":"0x532dc264800","nonce":null,"code":null,"storage":[]}},{"address":"0x00000000000000000000000000000000000000aa","pre":{"balance":null,"nonce":null,"code":null,"storage":[{"key":"0x0000000000000000000000000000000000000000000000000000000000000000","value":"0x0000000000000000000000000000000000000000000000000000000000000000"}]},"post":{"balance":null,"nonce":null,"code":null,"storage":[{"key":"0x0000000000000000000000000000000000000000000000000000000000000000","value":"0x0000000000000000000000000000000000000000000000000000000000000001"}]}},{"address":"0x71562b71999873db5b286df957af199ec94617f7","pre":{"balance":"0xde0b6b3a7640000","nonce":"0x0","code":null,"storage":[]},"post":{"balance":"0xde08d1cc631c000","nonce":"0x1","code":null,"storage":[]}}]}]}}`,
+		},
+		{
+			body: `{block(number: 1) { transactions { trace(tracer: "callTracer", config: {onlyTopCall: true}) } } }`,
+			want: `{"block":{"transactions":[{"trace":{"from":"0x71562b71999873db5b286df957af199ec94617f7","gas":"0x186a0","gasUsed":"0xb2a0","to":"0x00000000000000000000000000000000000000aa","input":"0x","value":"0x0","type":"CALL"}}]}}`,
+		},
+	} {
+		res := handler.Schema.Exec(context.Background(), tt.body, "", map[string]interface{}{})
+		if res.Errors != nil {
+			t.Fatalf("failed to execute query for testcase #%d: %v", i, res.Errors)
+		}
+		have, err := json.Marshal(res.Data)
+		if err != nil {
+			t.Fatalf("failed to encode graphql response for testcase #%d: %s", i, err)
+		}
+		if string(have) != tt.want {
+			t.Errorf("response unmatch for testcase #%d.\nhave:\n%s\nwant:\n%s", i, have, tt.want)
+		}
+	}
+	// JS tracers must be rejected.
+	res := handler.Schema.Exec(context.Background(), `{block(number: 1) { transactions { trace(tracer: "{result: function() { return 1 }, fault: function() {}}") } } }`, "", map[string]interface{}{})
+	if len(res.Errors) == 0 || res.Errors[0].Message != errJSTracer.Error() {
+		t.Errorf("expected JS tracer to be rejected, got %v", res.Errors)
+	}
+	// Tracing must be rejected if it is not enabled.
+	if _, err := (&Resolver{}).tracerAPI(); err != errTracingDisabled {
+		t.Errorf("expected tracing to be disabled, got %v", err)
+	}
+}
+
 // TestGraphQLMaxDepth ensures that queries exceeding the configured maximum depth
 // are rejected to prevent resource exhaustion from deeply nested operations.
 func TestGraphQLMaxDepth(t *testing.T) {
 	stack := createNode(t)
 	defer stack.Close()
 
-	h, err := newHandler(stack, nil, nil, []string{}, []string{})
+	h, err := newHandler(stack, nil, nil, []string{}, []string{}, false)
 	if err != nil {
 		t.Fatalf("could not create graphql service: %v", err)
 	}
@@ -512,7 +591,7 @@ func newGQLService(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Ge
 	}
 	// Set up handler
 	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
-	handler, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{})
+	handler, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{}, true)
 	if err != nil {
 		t.Fatalf("could not create graphql service: %v", err)
 	}
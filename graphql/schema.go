@@ -32,10 +32,14 @@ const schema string = `
     # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
     # 0x-prefixed hexadecimal.
     scalar Long
+    # JSON is an arbitrary JSON value. Input is accepted as a GraphQL literal or variable
+    # of any type, output values are passed through verbatim.
+    scalar JSON
 
     schema {
         query: Query
         mutation: Mutation
+        subscription: Subscription
     }
 
     # Account is an Ethereum account at a particular block.
@@ -170,6 +174,76 @@ const schema string = `
         rawReceipt: Bytes!
         # BlobVersionedHashes is a set of hash outputs from the blobs in the transaction.
         blobVersionedHashes: [Bytes32!]
+        # Trace re-executes the transaction with the given native tracer and returns its
+        # output. Config is the tracer specific configuration, e.g. {onlyTopCall: true}
+        # for the callTracer. If no tracer is given, the opcode logger is used and config
+        # holds its options. If the transaction is pending, this field will be null.
+        # The trace, callFrames and stateDiff fields are only available if tracing is
+        # enabled on the node (--graphql.tracing).
+        trace(tracer: String, config: JSON): JSON
+        # CallFrames is the list of calls executed by the transaction, including the
+        # top-level call, in execution order as reported by the callTracer. If the
+        # transaction is pending, this field will be null.
+        callFrames: [CallFrame!]
+        # StateDiff is the list of accounts modified by the transaction, sorted by
+        # address, as reported by the prestateTracer in diff mode. If the transaction
+        # is pending, this field will be null.
+        stateDiff: [AccountDiff!]
+    }
+
+    # CallFrame is a single message call or contract creation executed by a transaction.
+    type CallFrame {
+        # Type is the kind of call, e.g. CALL, STATICCALL, DELEGATECALL or CREATE2.
+        type: String!
+        # Depth is the call depth, zero for the top-level call of the transaction.
+        depth: Long!
+        # From is the address of the caller.
+        from: Address!
+        # To is the address of the callee, or of the created contract.
+        to: Address
+        # Value is the amount of wei transferred by the call.
+        value: BigInt
+        # Gas is the amount of gas provided to the call.
+        gas: Long!
+        # GasUsed is the amount of gas used by the call.
+        gasUsed: Long!
+        # Input is the call data, or the init code of a contract creation.
+        input: Bytes!
+        # Output is the data returned by the call.
+        output: Bytes
+        # Error is the reason the call failed, null if it succeeded.
+        error: String
+        # RevertReason is the decoded reason string of a reverted call.
+        revertReason: String
+        # Calls is the list of calls made by this call.
+        calls: [CallFrame!]!
+    }
+
+    # AccountDiff is the change a transaction made to the state of an account.
+    type AccountDiff {
+        # Address is the address of the account.
+        address: Address!
+        # Pre holds the modified fields before the transaction, null if the account
+        # was created by it.
+        pre: AccountState
+        # Post holds the modified fields after the transaction, null if the account
+        # was deleted by it.
+        post: AccountState
+    }
+
+    # AccountState holds fields of an account. Fields which were not modified by
+    # the transaction are null.
+    type AccountState {
+        balance: BigInt
+        nonce: Long
+        code: Bytes
+        storage: [StorageSlot!]!
+    }
+
+    # StorageSlot is a single slot of contract storage.
+    type StorageSlot {
+        key: Bytes32!
+        value: Bytes32!
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -388,4 +462,16 @@ const schema string = `
         # SendRawTransaction sends an RLP-encoded transaction to the network.
         sendRawTransaction(data: Bytes!): Bytes32!
     }
+
+    type Subscription {
+        # NewBlock emits every block added to the canonical chain. In case of a
+        # reorg, all blocks of the new chain segment are emitted.
+        newBlock: Block!
+        # NewLogs emits log entries matching the provided filter as they are
+        # included into the canonical chain.
+        newLogs(filter: BlockFilterCriteria!): Log!
+        # PendingTransactions emits every transaction added to the transaction
+        # pool.
+        pendingTransactions: Transaction!
+    }
 `
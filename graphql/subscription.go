@@ -0,0 +1,417 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// Websocket subprotocols understood by the subscription handler. The first
+	// one is spoken by the graphql-ws library, the second one by the deprecated
+	// subscriptions-transport-ws library.
+	subprotocolTransportWS = "graphql-transport-ws"
+	subprotocolLegacyWS    = "graphql-ws"
+
+	wsReadLimit       = 1024 * 1024
+	wsWriteTimeout    = 10 * time.Second
+	wsInitTimeout     = 10 * time.Second
+	wsMaxSubscription = 128
+
+	// Close codes defined by the graphql-transport-ws protocol.
+	closeBadRequest       = 4400
+	closeUnauthorized     = 4401
+	closeInitTimeout      = 4408
+	closeSubscriberExists = 4409
+	closeTooManyInits     = 4429
+)
+
+// NewBlock resolves the newBlock subscription, emitting every block added to
+// the canonical chain.
+func (r *Resolver) NewBlock(ctx context.Context) (<-chan *Block, error) {
+	var (
+		headers = make(chan *types.Header)
+		sub     = r.eventSystem().SubscribeNewHeads(headers)
+		blocks  = make(chan *Block)
+	)
+	go func() {
+		defer close(blocks)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case header := <-headers:
+				numberOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+				block := &Block{
+					r:            r,
+					numberOrHash: &numberOrHash,
+					hash:         header.Hash(),
+					header:       header,
+				}
+				select {
+				case blocks <- block:
+				case <-ctx.Done():
+					return
+				}
+			case <-sub.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return blocks, nil
+}
+
+// NewLogs resolves the newLogs subscription, emitting every log matching the
+// filter in newly imported blocks. Note the field can't be named logs as the
+// query and subscription roots share the same resolver.
+func (r *Resolver) NewLogs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) (<-chan *Log, error) {
+	var crit ethereum.FilterQuery
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	matches := make(chan []*types.Log)
+	sub, err := r.eventSystem().SubscribeLogs(crit, matches)
+	if err != nil {
+		return nil, err
+	}
+	logs := make(chan *Log)
+	go func() {
+		defer close(logs)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case batch := <-matches:
+				for _, l := range batch {
+					select {
+					case logs <- &Log{r: r, transaction: &Transaction{r: r, hash: l.TxHash}, log: l}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			case <-sub.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return logs, nil
+}
+
+// PendingTransactions resolves the pendingTransactions subscription, emitting
+// every transaction entering the transaction pool.
+func (r *Resolver) PendingTransactions(ctx context.Context) (<-chan *Transaction, error) {
+	var (
+		pending = make(chan []*types.Transaction, 128)
+		sub     = r.eventSystem().SubscribePendingTxs(pending)
+		txs     = make(chan *Transaction)
+	)
+	go func() {
+		defer close(txs)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case batch := <-pending:
+				for _, tx := range batch {
+					select {
+					case txs <- &Transaction{r: r, hash: tx.Hash(), tx: tx}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			case <-sub.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return txs, nil
+}
+
+// eventSystem returns the event system feeding the subscriptions, creating
+// it on first use.
+func (r *Resolver) eventSystem() *filters.EventSystem {
+	r.eventsOnce.Do(func() {
+		r.events = filters.NewEventSystem(r.filterSystem)
+	})
+	return r.events
+}
+
+// wsMessage is a message of the GraphQL over websocket protocols.
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// wsHandler serves GraphQL subscriptions over websocket connections.
+type wsHandler struct {
+	schema   *graphql.Schema
+	upgrader websocket.Upgrader
+}
+
+// newWSHandler creates a websocket handler for GraphQL subscriptions, accepting
+// connections from the given origins.
+func newWSHandler(schema *graphql.Schema, origins []string) *wsHandler {
+	return &wsHandler{
+		schema: schema,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{subprotocolTransportWS, subprotocolLegacyWS},
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" {
+					return true // Non-browser client
+				}
+				return slices.Contains(origins, "*") || slices.Contains(origins, origin)
+			},
+		},
+	}
+}
+
+func (h *wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("GraphQL websocket upgrade failed", "err", err)
+		return
+	}
+	conn.SetReadLimit(wsReadLimit)
+	// The http server may have set deadlines on the hijacked connection.
+	conn.SetReadDeadline(time.Time{})
+
+	c := &wsConn{
+		conn:   conn,
+		schema: h.schema,
+		legacy: conn.Subprotocol() == subprotocolLegacyWS,
+		ops:    make(map[string]context.CancelFunc),
+	}
+	c.serve(r.Context())
+}
+
+// wsConn is a single websocket connection serving GraphQL operations.
+type wsConn struct {
+	conn   *websocket.Conn
+	schema *graphql.Schema
+	legacy bool // Whether the connection speaks subscriptions-transport-ws
+
+	writeMu sync.Mutex // Serializes writes to the connection
+
+	mu    sync.Mutex
+	inits int
+	ops   map[string]context.CancelFunc
+}
+
+// serve reads client messages until the connection is closed, running the
+// requested operations in the background.
+func (c *wsConn) serve(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer c.conn.Close()
+
+	// Clients must initialize the connection within a reasonable time.
+	initTimer := time.AfterFunc(wsInitTimeout, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.inits == 0 {
+			c.close(closeInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimer.Stop()
+
+	for {
+		var msg wsMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if _, ok := err.(*websocket.CloseError); !ok {
+				log.Debug("GraphQL websocket read failed", "err", err)
+			}
+			return
+		}
+		if !c.handle(ctx, &msg) {
+			return
+		}
+	}
+}
+
+// handle processes a single client message. It returns false if the connection
+// should be terminated.
+func (c *wsConn) handle(ctx context.Context, msg *wsMessage) bool {
+	switch msg.Type {
+	case "connection_init":
+		c.mu.Lock()
+		c.inits++
+		inits := c.inits
+		c.mu.Unlock()
+		if inits > 1 {
+			c.close(closeTooManyInits, "Too many initialisation requests")
+			return false
+		}
+		c.send(&wsMessage{Type: "connection_ack"})
+		if c.legacy {
+			c.send(&wsMessage{Type: "ka"})
+		}
+
+	case "ping":
+		c.send(&wsMessage{Type: "pong", Payload: msg.Payload})
+
+	case "pong":
+
+	case "subscribe", "start":
+		c.mu.Lock()
+		inited := c.inits > 0
+		c.mu.Unlock()
+		if !inited {
+			c.close(closeUnauthorized, "Unauthorized")
+			return false
+		}
+		var params struct {
+			Query         string                 `json:"query"`
+			OperationName string                 `json:"operationName"`
+			Variables     map[string]interface{} `json:"variables"`
+		}
+		if msg.ID == "" || json.Unmarshal(msg.Payload, &params) != nil {
+			c.close(closeBadRequest, "Invalid message received")
+			return false
+		}
+		return c.start(ctx, msg.ID, params.Query, params.OperationName, params.Variables)
+
+	case "complete", "stop":
+		c.mu.Lock()
+		cancel, ok := c.ops[msg.ID]
+		delete(c.ops, msg.ID)
+		c.mu.Unlock()
+		if ok {
+			cancel()
+		}
+
+	case "connection_terminate":
+		return false
+
+	default:
+		c.close(closeBadRequest, "Invalid message received")
+		return false
+	}
+	return true
+}
+
+// start executes an operation, streaming its results to the client until the
+// operation completes or is stopped.
+func (c *wsConn) start(ctx context.Context, id string, query string, operationName string, variables map[string]interface{}) bool {
+	c.mu.Lock()
+	if _, exists := c.ops[id]; exists {
+		c.mu.Unlock()
+		c.close(closeSubscriberExists, "Subscriber for "+id+" already exists")
+		return false
+	}
+	if len(c.ops) >= wsMaxSubscription {
+		c.mu.Unlock()
+		c.sendErrors(id, "too many active subscriptions")
+		return true
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c.ops[id] = cancel
+	c.mu.Unlock()
+
+	responses, err := c.schema.Subscribe(ctx, query, operationName, variables)
+	if err != nil {
+		c.finish(id)
+		c.sendErrors(id, err.Error())
+		return true
+	}
+	go func() {
+		for response := range responses {
+			payload, err := json.Marshal(response)
+			if err != nil {
+				log.Warn("Failed to encode GraphQL response", "err", err)
+				continue
+			}
+			typ := "next"
+			if c.legacy {
+				typ = "data"
+			}
+			if err := c.send(&wsMessage{ID: id, Type: typ, Payload: payload}); err != nil {
+				cancel()
+			}
+		}
+		// Notify the client, unless the operation was stopped by it.
+		if c.finish(id) {
+			c.send(&wsMessage{ID: id, Type: "complete"})
+		}
+	}()
+	return true
+}
+
+// finish removes an operation from the active set, reporting whether it was
+// still active.
+func (c *wsConn) finish(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cancel, ok := c.ops[id]
+	if ok {
+		cancel()
+		delete(c.ops, id)
+	}
+	return ok
+}
+
+// sendErrors reports an operation failure to the client.
+func (c *wsConn) sendErrors(id string, message string) error {
+	errs := []map[string]string{{"message": message}}
+	if c.legacy {
+		payload, _ := json.Marshal(errs[0])
+		return c.send(&wsMessage{ID: id, Type: "error", Payload: payload})
+	}
+	payload, _ := json.Marshal(errs)
+	return c.send(&wsMessage{ID: id, Type: "error", Payload: payload})
+}
+
+func (c *wsConn) send(msg *wsMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteJSON(msg)
+}
+
+func (c *wsConn) close(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	deadline := time.Now().Add(wsWriteTimeout)
+	c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+	c.conn.Close()
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/gorilla/websocket"
+)
+
+func dialGraphQLWS(t *testing.T, endpoint string, subprotocol string) *websocket.Conn {
+	dialer := websocket.Dialer{Subprotocols: []string{subprotocol}}
+	url := strings.Replace(endpoint, "http://", "ws://", 1) + "/graphql"
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("could not dial websocket: %v", err)
+	}
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	return conn
+}
+
+func readWSMessage(t *testing.T, conn *websocket.Conn) *wsMessage {
+	var msg wsMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatalf("could not read message: %v", err)
+	}
+	return &msg
+}
+
+func TestGraphQLSubscriptions(t *testing.T) {
+	stack := createNode(t)
+	defer stack.Close()
+
+	genesis := &core.Genesis{
+		Config:     params.AllEthashProtocolChanges,
+		GasLimit:   11500000,
+		Difficulty: big.NewInt(1048576),
+	}
+	ethBackend, err := eth.New(stack, &ethconfig.Config{
+		Genesis:        genesis,
+		NetworkId:      1337,
+		TrieCleanCache: 5,
+		TrieDirtyCache: 5,
+		TrieTimeout:    60 * time.Minute,
+		SnapshotCache:  5,
+		StateScheme:    rawdb.HashScheme,
+	})
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
+	if _, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{}, true); err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	conn := dialGraphQLWS(t, stack.HTTPEndpoint(), subprotocolTransportWS)
+	defer conn.Close()
+
+	// Subscribing before initialising the connection is not allowed.
+	unauth := dialGraphQLWS(t, stack.HTTPEndpoint(), subprotocolTransportWS)
+	defer unauth.Close()
+	unauth.WriteJSON(&wsMessage{ID: "1", Type: "subscribe", Payload: json.RawMessage(`{"query":"subscription{newBlock{number}}"}`)})
+	if _, _, err := unauth.ReadMessage(); !websocket.IsCloseError(err, closeUnauthorized) {
+		t.Fatalf("expected unauthorized close, got %v", err)
+	}
+
+	conn.WriteJSON(&wsMessage{Type: "connection_init"})
+	if msg := readWSMessage(t, conn); msg.Type != "connection_ack" {
+		t.Fatalf("expected connection_ack, got %s", msg.Type)
+	}
+	conn.WriteJSON(&wsMessage{Type: "ping"})
+	if msg := readWSMessage(t, conn); msg.Type != "pong" {
+		t.Fatalf("expected pong, got %s", msg.Type)
+	}
+	conn.WriteJSON(&wsMessage{ID: "1", Type: "subscribe", Payload: json.RawMessage(`{"query":"subscription{newBlock{number}}"}`)})
+
+	// Queries are executed over the socket too, completing after the result.
+	conn.WriteJSON(&wsMessage{ID: "2", Type: "subscribe", Payload: json.RawMessage(`{"query":"{block{number}}"}`)})
+	if msg := readWSMessage(t, conn); msg.ID != "2" || msg.Type != "next" || string(msg.Payload) != `{"data":{"block":{"number":"0x0"}}}` {
+		t.Fatalf("unexpected query result: %s %s %s", msg.ID, msg.Type, msg.Payload)
+	}
+	if msg := readWSMessage(t, conn); msg.ID != "2" || msg.Type != "complete" {
+		t.Fatalf("expected query completion, got %s %s", msg.ID, msg.Type)
+	}
+	// Give the subscription time to be installed, then import some blocks.
+	time.Sleep(100 * time.Millisecond)
+	chain, _ := core.GenerateChain(genesis.Config, ethBackend.BlockChain().Genesis(), beacon.New(ethash.NewFaker()), ethBackend.ChainDb(), 3, func(i int, gen *core.BlockGen) {})
+	if _, err := ethBackend.BlockChain().InsertChain(chain); err != nil {
+		t.Fatalf("could not import blocks: %v", err)
+	}
+	for i := 1; i <= len(chain); i++ {
+		msg := readWSMessage(t, conn)
+		want := fmt.Sprintf(`{"data":{"newBlock":{"number":"%#x"}}}`, i)
+		if msg.ID != "1" || msg.Type != "next" || string(msg.Payload) != want {
+			t.Fatalf("unexpected notification: %s %s %s, want %s", msg.ID, msg.Type, msg.Payload, want)
+		}
+	}
+	// Stopping the subscription must not produce a completion message.
+	conn.WriteJSON(&wsMessage{ID: "1", Type: "complete"})
+	conn.WriteJSON(&wsMessage{Type: "ping"})
+	if msg := readWSMessage(t, conn); msg.Type != "pong" {
+		t.Fatalf("expected pong after completion, got %s %s", msg.Type, msg.Payload)
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // Register the tracers backing the trace fields
+)
+
+const (
+	callTracerName     = "callTracer"
+	prestateTracerName = "prestateTracer"
+
+	// traceTimeout bounds the time spent executing the transactions of a block
+	// to resolve a trace field.
+	traceTimeout = 10 * time.Second
+)
+
+var (
+	errTracingDisabled    = errors.New("tracing is not enabled on this endpoint")
+	errTracingUnsupported = errors.New("tracing is not supported by the backend")
+	errJSTracer           = errors.New("only native tracers are supported")
+
+	// traceTimeoutString is the per transaction timeout passed to the tracers.
+	traceTimeoutString = traceTimeout.String()
+
+	// prestateDiffConfig configures the prestateTracer to report state changes.
+	prestateDiffConfig = json.RawMessage(`{"diffMode":true}`)
+)
+
+// JSON is an arbitrary JSON value, passed through verbatim.
+type JSON json.RawMessage
+
+// ImplementsGraphQLType returns true if JSON implements the provided GraphQL type.
+func (j JSON) ImplementsGraphQLType(name string) bool { return name == "JSON" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	blob, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("unexpected value for JSON: %v", err)
+	}
+	*j = blob
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("null"), nil
+	}
+	return j, nil
+}
+
+// tracerAPI returns the tracing API, if tracing is enabled and supported by
+// the backend.
+func (r *Resolver) tracerAPI() (*tracers.API, error) {
+	if !r.tracing {
+		return nil, errTracingDisabled
+	}
+	backend, ok := r.backend.(tracers.Backend)
+	if !ok {
+		return nil, errTracingUnsupported
+	}
+	return tracers.NewAPI(backend), nil
+}
+
+// newTraceConfig returns the config running the given tracer.
+func newTraceConfig(tracer string, config json.RawMessage) *tracers.TraceConfig {
+	return &tracers.TraceConfig{Tracer: &tracer, TracerConfig: config, Timeout: &traceTimeoutString}
+}
+
+// blockTraces returns the results of tracing all transactions in the block with
+// the given config. Results are cached per config, so resolving the traces of
+// every transaction in a block only executes the block once.
+func (b *Block) blockTraces(ctx context.Context, config *tracers.TraceConfig) ([]json.RawMessage, error) {
+	key, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	b.traceMu.Lock()
+	defer b.traceMu.Unlock()
+
+	if traces, ok := b.traces[string(key)]; ok {
+		return traces, nil
+	}
+	api, err := b.r.tracerAPI()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, traceTimeout)
+	defer cancel()
+
+	stream, err := api.TraceBlockByHash(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+	results, err := stream.Collect()
+	if err != nil {
+		return nil, err
+	}
+	traces := make([]json.RawMessage, len(results))
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("tracing transaction %s failed: %s", res.TxHash, res.Error)
+		}
+		if traces[i], err = json.Marshal(res.Result); err != nil {
+			return nil, err
+		}
+	}
+	if b.traces == nil {
+		b.traces = make(map[string][]json.RawMessage)
+	}
+	b.traces[string(key)] = traces
+	return traces, nil
+}
+
+// txTrace returns the result of tracing the transaction as part of its block
+// with the given config, or nil if the transaction is pending.
+func (t *Transaction) txTrace(ctx context.Context, config *tracers.TraceConfig) (json.RawMessage, error) {
+	if !t.r.tracing {
+		return nil, errTracingDisabled
+	}
+	tx, block := t.resolve(ctx)
+	if tx == nil || block == nil {
+		return nil, nil
+	}
+	traces, err := block.blockTraces(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if t.index >= uint64(len(traces)) {
+		return nil, fmt.Errorf("transaction index %d out of range", t.index)
+	}
+	return traces[t.index], nil
+}
+
+// Trace executes the transaction with the given tracer and config, returning
+// the tracer output. Without a tracer, the struct logger is used. Only native
+// tracers are accepted, JS tracers are rejected.
+//
+// The whole block is traced once per tracer and config, the traces of the other
+// transactions in the block are served from the cache.
+func (t *Transaction) Trace(ctx context.Context, args struct {
+	Tracer *string
+	Config *JSON
+}) (*JSON, error) {
+	if !t.r.tracing {
+		return nil, errTracingDisabled
+	}
+	if args.Tracer != nil && tracers.DefaultDirectory.IsJS(*args.Tracer) {
+		return nil, errJSTracer
+	}
+	config := &tracers.TraceConfig{Timeout: &traceTimeoutString}
+	if args.Tracer != nil {
+		config.Tracer = args.Tracer
+		if args.Config != nil {
+			config.TracerConfig = json.RawMessage(*args.Config)
+		}
+	} else if args.Config != nil {
+		if err := json.Unmarshal(*args.Config, &config.Config); err != nil {
+			return nil, fmt.Errorf("invalid logger config: %v", err)
+		}
+	}
+	trace, err := t.txTrace(ctx, config)
+	if trace == nil || err != nil {
+		return nil, err
+	}
+	return (*JSON)(&trace), nil
+}
+
+// CallFrames returns the calls executed by the transaction in execution order,
+// as reported by the callTracer.
+func (t *Transaction) CallFrames(ctx context.Context) (*[]*CallFrame, error) {
+	trace, err := t.txTrace(ctx, newTraceConfig(callTracerName, nil))
+	if trace == nil || err != nil {
+		return nil, err
+	}
+	root := new(callFrame)
+	if err := json.Unmarshal(trace, root); err != nil {
+		return nil, err
+	}
+	var frames []*CallFrame
+	var walk func(frame *CallFrame)
+	walk = func(frame *CallFrame) {
+		frames = append(frames, frame)
+		for _, call := range frame.Calls() {
+			walk(call)
+		}
+	}
+	walk(&CallFrame{frame: root})
+	return &frames, nil
+}
+
+// StateDiff returns the accounts modified by the transaction, as reported by the
+// prestateTracer in diff mode.
+func (t *Transaction) StateDiff(ctx context.Context) (*[]*AccountDiff, error) {
+	trace, err := t.txTrace(ctx, newTraceConfig(prestateTracerName, prestateDiffConfig))
+	if trace == nil || err != nil {
+		return nil, err
+	}
+	var diff struct {
+		Pre  map[common.Address]*accountState `json:"pre"`
+		Post map[common.Address]*accountState `json:"post"`
+	}
+	if err := json.Unmarshal(trace, &diff); err != nil {
+		return nil, err
+	}
+	addrs := make([]common.Address, 0, len(diff.Pre)+len(diff.Post))
+	for addr := range diff.Pre {
+		addrs = append(addrs, addr)
+	}
+	for addr := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	slices.SortFunc(addrs, common.Address.Cmp)
+
+	diffs := make([]*AccountDiff, len(addrs))
+	for i, addr := range addrs {
+		pre, post := diff.Pre[addr], diff.Post[addr]
+		if pre != nil && post != nil {
+			normalizeDiff(pre, post)
+		}
+		diffs[i] = &AccountDiff{address: addr, pre: pre, post: post}
+	}
+	return &diffs, nil
+}
+
+// normalizeDiff aligns the pre and post states of an account modified by a
+// transaction, so both report exactly the modified fields. The prestateTracer
+// reports the full previous state and omits zero values, e.g. the nonce of a
+// fresh account or a cleared storage slot.
+func normalizeDiff(pre, post *accountState) {
+	switch {
+	case post.Balance == nil:
+		pre.Balance = nil
+	case pre.Balance == nil:
+		pre.Balance = new(hexutil.Big)
+	}
+	switch {
+	case post.Nonce == nil:
+		pre.Nonce = nil
+	case pre.Nonce == nil:
+		pre.Nonce = new(uint64)
+	}
+	switch {
+	case post.Code == nil:
+		pre.Code = nil
+	case pre.Code == nil:
+		pre.Code = &hexutil.Bytes{}
+	}
+	for key := range post.Storage {
+		if _, ok := pre.Storage[key]; !ok {
+			if pre.Storage == nil {
+				pre.Storage = make(map[common.Hash]common.Hash)
+			}
+			pre.Storage[key] = common.Hash{}
+		}
+	}
+	for key := range pre.Storage {
+		if _, ok := post.Storage[key]; !ok {
+			if post.Storage == nil {
+				post.Storage = make(map[common.Hash]common.Hash)
+			}
+			post.Storage[key] = common.Hash{}
+		}
+	}
+}
+
+// callFrame is a call reported by the callTracer.
+type callFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to"`
+	Value        *hexutil.Big    `json:"value"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       *hexutil.Bytes  `json:"output"`
+	Error        string          `json:"error"`
+	RevertReason string          `json:"revertReason"`
+	Calls        []*callFrame    `json:"calls"`
+}
+
+// CallFrame represents a single call executed by a transaction.
+type CallFrame struct {
+	frame *callFrame
+	depth uint64
+}
+
+func (c *CallFrame) Type() string {
+	return c.frame.Type
+}
+
+func (c *CallFrame) Depth() hexutil.Uint64 {
+	return hexutil.Uint64(c.depth)
+}
+
+func (c *CallFrame) From() common.Address {
+	return c.frame.From
+}
+
+func (c *CallFrame) To() *common.Address {
+	return c.frame.To
+}
+
+func (c *CallFrame) Value() *hexutil.Big {
+	return c.frame.Value
+}
+
+func (c *CallFrame) Gas() hexutil.Uint64 {
+	return c.frame.Gas
+}
+
+func (c *CallFrame) GasUsed() hexutil.Uint64 {
+	return c.frame.GasUsed
+}
+
+func (c *CallFrame) Input() hexutil.Bytes {
+	return c.frame.Input
+}
+
+func (c *CallFrame) Output() *hexutil.Bytes {
+	return c.frame.Output
+}
+
+func (c *CallFrame) Error() *string {
+	if c.frame.Error == "" {
+		return nil
+	}
+	return &c.frame.Error
+}
+
+func (c *CallFrame) RevertReason() *string {
+	if c.frame.RevertReason == "" {
+		return nil
+	}
+	return &c.frame.RevertReason
+}
+
+func (c *CallFrame) Calls() []*CallFrame {
+	calls := make([]*CallFrame, len(c.frame.Calls))
+	for i, call := range c.frame.Calls {
+		calls[i] = &CallFrame{frame: call, depth: c.depth + 1}
+	}
+	return calls
+}
+
+// accountState is an account reported by the prestateTracer.
+type accountState struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Nonce   *uint64                     `json:"nonce"`
+	Code    *hexutil.Bytes              `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// AccountDiff represents the changes a transaction made to an account.
+type AccountDiff struct {
+	address   common.Address
+	pre, post *accountState
+}
+
+func (a *AccountDiff) Address() common.Address {
+	return a.address
+}
+
+func (a *AccountDiff) Pre() *AccountState {
+	if a.pre == nil {
+		return nil
+	}
+	return &AccountState{a.pre}
+}
+
+func (a *AccountDiff) Post() *AccountState {
+	if a.post == nil {
+		return nil
+	}
+	return &AccountState{a.post}
+}
+
+// AccountState represents the fields of an account touched by a transaction.
+type AccountState struct {
+	state *accountState
+}
+
+func (a *AccountState) Balance() *hexutil.Big {
+	return a.state.Balance
+}
+
+func (a *AccountState) Nonce() *hexutil.Uint64 {
+	if a.state.Nonce == nil {
+		return nil
+	}
+	nonce := hexutil.Uint64(*a.state.Nonce)
+	return &nonce
+}
+
+func (a *AccountState) Code() *hexutil.Bytes {
+	return a.state.Code
+}
+
+func (a *AccountState) Storage() []*StorageSlot {
+	keys := make([]common.Hash, 0, len(a.state.Storage))
+	for key := range a.state.Storage {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, common.Hash.Cmp)
+
+	slots := make([]*StorageSlot, len(keys))
+	for i, key := range keys {
+		slots[i] = &StorageSlot{key: key, value: a.state.Storage[key]}
+	}
+	return slots
+}
+
+// StorageSlot represents a storage slot of an account.
+type StorageSlot struct {
+	key, value common.Hash
+}
+
+func (s *StorageSlot) Key() common.Hash {
+	return s.key
+}
+
+func (s *StorageSlot) Value() common.Hash {
+	return s.value
+}
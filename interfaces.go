@@ -205,6 +205,13 @@ type FilterQuery struct {
 	// {{A}, {B}}         matches topic A in first position AND B in second position
 	// {{A, B}, {C, D}}   matches topic (A OR B) in first position AND (C OR D) in second position
 	Topics [][]common.Hash
+
+	// Cursor and Limit select a single page of the results of eth_getLogsPage.
+	// Cursor is the continuation token returned with the previous page, Limit the
+	// maximum number of logs in the page. Other log queries and subscriptions
+	// reject them.
+	Cursor string
+	Limit  uint64
 }
 
 // LogFilterer provides access to contract log events using a one-off query or continuous
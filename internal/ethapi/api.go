@@ -36,9 +36,12 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/eth/gasestimator"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/internal/ethapi/override"
@@ -53,6 +56,14 @@ import (
 // allowed to produce in order to speed up calculations.
 const estimateGasErrorRatio = 0.015
 
+// maxEstimateGasBundleCalls is the maximum number of calls eth_estimateGasBundle
+// accepts in a single request.
+const maxEstimateGasBundleCalls = 16
+
+// defaultPrivateTxBlocks is the number of blocks a private transaction is kept
+// in the pool for if no maximum block number is requested.
+const defaultPrivateTxBlocks = 25
+
 var errBlobTxNotSupported = errors.New("signing blob transactions not supported")
 var errSubClosed = errors.New("chain subscription closed")
 
@@ -138,6 +149,41 @@ func (api *EthereumAPI) BlobBaseFee(ctx context.Context) *hexutil.Big {
 	return (*hexutil.Big)(api.b.BlobBaseFee(ctx))
 }
 
+type feeEstimateResult struct {
+	Blocks               hexutil.Uint64 `json:"blocks"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas,omitempty"`
+	BaseFee              *hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	MaxFeePerBlobGas     *hexutil.Big   `json:"maxFeePerBlobGas,omitempty"`
+	Confidence           float64        `json:"confidence"`
+}
+
+// FeeEstimates returns fee suggestions for transactions to be included within
+// several confirmation targets, considering both the recent blocks and the
+// pending transactions. The fee caps cover the highest base fees projected
+// within each target.
+func (api *EthereumAPI) FeeEstimates(ctx context.Context) ([]*feeEstimateResult, error) {
+	estimates, err := api.b.FeeEstimates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*feeEstimateResult, 0, len(estimates))
+	for _, estimate := range estimates {
+		result := &feeEstimateResult{
+			Blocks:               hexutil.Uint64(estimate.Blocks),
+			MaxPriorityFeePerGas: (*hexutil.Big)(estimate.TipCap),
+			MaxFeePerBlobGas:     (*hexutil.Big)(estimate.BlobBaseFee),
+			Confidence:           estimate.Confidence,
+		}
+		if estimate.BaseFee != nil {
+			result.BaseFee = (*hexutil.Big)(estimate.BaseFee)
+			result.MaxFeePerGas = (*hexutil.Big)(new(big.Int).Add(estimate.BaseFee, estimate.TipCap))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 // Syncing returns false in case the node is currently not syncing with the network. It can be up-to-date or has not
 // yet received the latest block headers from its peers. In case it is synchronizing:
 // - startingBlock: block number this node started to synchronize from
@@ -272,6 +318,64 @@ func (api *TxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	return content
 }
 
+// txDiagnosisResult is the status of a pooled transaction returned by
+// txpool_diagnose.
+type txDiagnosisResult struct {
+	Hash     common.Hash    `json:"hash"`
+	Nonce    hexutil.Uint64 `json:"nonce"`
+	Type     hexutil.Uint64 `json:"type"`
+	Status   string         `json:"status"`
+	Cost     *hexutil.Big   `json:"cumulativeCost"`
+	Blockers []string       `json:"blockers"`
+}
+
+// accountDiagnosisResult explains the pooled transactions of an account for
+// txpool_diagnose.
+type accountDiagnosisResult struct {
+	Nonce         hexutil.Uint64       `json:"stateNonce"`
+	Balance       *hexutil.Big         `json:"balance"`
+	RejectedTypes []hexutil.Uint64     `json:"rejectedTypes"`
+	Transactions  []*txDiagnosisResult `json:"transactions"`
+}
+
+// Diagnose reports why the pooled transactions of an account are not pending or
+// not included: nonce gaps, insufficient balance for the cumulative cost, tips
+// below the pool minimum and exceeded account slots. It also reports the
+// transaction types rejected from the account, as its reservation is held by
+// another subpool (e.g. a pending blob transaction blocks all other types).
+func (api *TxPoolAPI) Diagnose(addr common.Address) *accountDiagnosisResult {
+	diag := api.b.TxPoolDiagnose(addr)
+
+	result := &accountDiagnosisResult{
+		Nonce:         hexutil.Uint64(diag.Nonce),
+		Balance:       (*hexutil.Big)(diag.Balance.ToBig()),
+		RejectedTypes: make([]hexutil.Uint64, 0, len(diag.RejectedTypes)),
+		Transactions:  make([]*txDiagnosisResult, 0, len(diag.Txs)),
+	}
+	for _, kind := range diag.RejectedTypes {
+		result.RejectedTypes = append(result.RejectedTypes, hexutil.Uint64(kind))
+	}
+	for _, tx := range diag.Txs {
+		status := "pending"
+		if tx.Status == txpool.TxStatusQueued {
+			status = "queued"
+		}
+		blockers := make([]string, 0, len(tx.Blockers))
+		for _, blocker := range tx.Blockers {
+			blockers = append(blockers, blocker.String())
+		}
+		result.Transactions = append(result.Transactions, &txDiagnosisResult{
+			Hash:     tx.Hash,
+			Nonce:    hexutil.Uint64(tx.Nonce),
+			Type:     hexutil.Uint64(tx.Type),
+			Status:   status,
+			Cost:     (*hexutil.Big)(tx.Cost.ToBig()),
+			Blockers: blockers,
+		})
+	}
+	return result
+}
+
 // EthereumAccountAPI provides an API to access accounts managed by this node.
 // It offers only methods that can retrieve accounts.
 type EthereumAccountAPI struct {
@@ -625,6 +729,45 @@ func (api *BlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rp
 	return result, nil
 }
 
+// blobSidecarResult is the sidecar of a blob transaction returned by
+// eth_getBlobSidecars.
+type blobSidecarResult struct {
+	BlockHash        common.Hash          `json:"blockHash"`
+	BlockNumber      hexutil.Uint64       `json:"blockNumber"`
+	TransactionHash  common.Hash          `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64       `json:"transactionIndex"`
+	Blobs            []kzg4844.Blob       `json:"blobs"`
+	Commitments      []kzg4844.Commitment `json:"commitments"`
+	Proofs           []kzg4844.Proof      `json:"proofs"`
+}
+
+// GetBlobSidecars returns the sidecars of the blob transactions included in the
+// given block. Sidecars are retained beyond finality only if the node archives
+// them, and only for the transactions that went through its pool.
+func (api *BlockChainAPI) GetBlobSidecars(ctx context.Context, blockHash common.Hash) ([]*blobSidecarResult, error) {
+	block, err := api.b.BlockByHash(ctx, blockHash)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	sidecars, err := api.b.GetBlobSidecars(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*blobSidecarResult, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		results = append(results, &blobSidecarResult{
+			BlockHash:        block.Hash(),
+			BlockNumber:      hexutil.Uint64(block.NumberU64()),
+			TransactionHash:  sidecar.TxHash,
+			TransactionIndex: hexutil.Uint64(sidecar.TxIndex),
+			Blobs:            sidecar.Sidecar.Blobs,
+			Commitments:      sidecar.Sidecar.Commitments,
+			Proofs:           sidecar.Sidecar.Proofs,
+		})
+	}
+	return results, nil
+}
+
 // ChainContextBackend provides methods required to implement ChainContext.
 type ChainContextBackend interface {
 	Engine() consensus.Engine
@@ -834,50 +977,105 @@ func (api *BlockChainAPI) SimulateV1(ctx context.Context, opts simOpts, blockNrO
 // there are unexpected failures. The gas limit is capped by both `args.Gas` (if non-nil &
 // non-zero) and `gasCap` (if non-zero).
 func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides, gasCap uint64) (hexutil.Uint64, error) {
+	opts, err := estimateGasOptions(ctx, b, blockNrOrHash, overrides, blockOverrides)
+	if opts == nil || err != nil {
+		return 0, err
+	}
+	call, err := estimateGasMessage(b, args, opts.Header, gasCap)
+	if err != nil {
+		return 0, err
+	}
+
+	// Run the gas estimation and wrap any revertals into a custom return
+	estimate, revert, err := gasestimator.Estimate(ctx, call, opts, gasCap)
+	if err != nil {
+		if errors.Is(err, vm.ErrExecutionReverted) {
+			return 0, newRevertError(revert)
+		}
+		return 0, err
+	}
+	return hexutil.Uint64(estimate), nil
+}
+
+// estimateGasOptions retrieves the state to estimate gas on, mutated with any
+// overrides, and assembles the gas estimator options from it.
+func estimateGasOptions(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides) (*gasestimator.Options, error) {
 	// Retrieve the base state and mutate it with any overrides
 	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
-		return 0, err
+		return nil, err
 	}
 	blockCtx := core.NewEVMBlockContext(header, NewChainContext(ctx, b), nil)
 	if blockOverrides != nil {
 		if err := blockOverrides.Apply(&blockCtx); err != nil {
-			return 0, err
+			return nil, err
 		}
 	}
 	rules := b.ChainConfig().Rules(blockCtx.BlockNumber, blockCtx.Random != nil, blockCtx.Time)
 	precompiles := vm.ActivePrecompiledContracts(rules)
 	if err := overrides.Apply(state, precompiles); err != nil {
-		return 0, err
+		return nil, err
 	}
 	// Construct the gas estimator option from the user input
-	opts := &gasestimator.Options{
+	return &gasestimator.Options{
 		Config:         b.ChainConfig(),
 		Chain:          NewChainContext(ctx, b),
 		Header:         header,
 		BlockOverrides: blockOverrides,
 		State:          state,
 		ErrorRatio:     estimateGasErrorRatio,
-	}
+	}, nil
+}
+
+// estimateGasMessage converts the transaction arguments to the message to run
+// the gas estimation with.
+func estimateGasMessage(b Backend, args TransactionArgs, header *types.Header, gasCap uint64) (*core.Message, error) {
 	// Set any required transaction default, but make sure the gas cap itself is not messed with
 	// if it was not specified in the original argument list.
 	if args.Gas == nil {
 		args.Gas = new(hexutil.Uint64)
 	}
 	if err := args.CallDefaults(gasCap, header.BaseFee, b.ChainConfig().ChainID); err != nil {
-		return 0, err
+		return nil, err
 	}
-	call := args.ToMessage(header.BaseFee, true)
+	return args.ToMessage(header.BaseFee, true), nil
+}
 
+// DoEstimateGasBundle estimates the gas limits of a sequence of dependent calls,
+// each of them on top of the state produced by the previous ones. The gas cap
+// bounds the total gas of the bundle, not that of each individual call.
+func DoEstimateGasBundle(ctx context.Context, b Backend, calls []TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides, gasCap uint64) ([]hexutil.Uint64, error) {
+	if len(calls) == 0 {
+		return nil, &invalidParamsError{message: "empty call bundle"}
+	}
+	if len(calls) > maxEstimateGasBundleCalls {
+		return nil, &clientLimitExceededError{message: fmt.Sprintf("too many calls in bundle: %d > %d", len(calls), maxEstimateGasBundleCalls)}
+	}
+	opts, err := estimateGasOptions(ctx, b, blockNrOrHash, overrides, blockOverrides)
+	if opts == nil || err != nil {
+		return nil, err
+	}
+	msgs := make([]*core.Message, len(calls))
+	for i, args := range calls {
+		if msgs[i], err = estimateGasMessage(b, args, opts.Header, gasCap); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+	}
 	// Run the gas estimation and wrap any revertals into a custom return
-	estimate, revert, err := gasestimator.Estimate(ctx, call, opts, gasCap)
+	estimates, failed, revert, err := gasestimator.EstimateSequence(ctx, msgs, opts, gasCap)
 	if err != nil {
 		if errors.Is(err, vm.ErrExecutionReverted) {
-			return 0, newRevertError(revert)
+			rerr := newRevertError(revert)
+			rerr.error = fmt.Errorf("call %d: %w", failed, rerr.error)
+			return nil, rerr
 		}
-		return 0, err
+		return nil, fmt.Errorf("call %d: %w", failed, err)
 	}
-	return hexutil.Uint64(estimate), nil
+	result := make([]hexutil.Uint64, len(estimates))
+	for i, estimate := range estimates {
+		result[i] = hexutil.Uint64(estimate)
+	}
+	return result, nil
 }
 
 // EstimateGas returns the lowest possible gas limit that allows the transaction to run
@@ -894,6 +1092,19 @@ func (api *BlockChainAPI) EstimateGas(ctx context.Context, args TransactionArgs,
 	return DoEstimateGas(ctx, api.b, args, bNrOrHash, overrides, blockOverrides, api.b.RPCGasCap())
 }
 
+// EstimateGasBundle returns the lowest possible gas limits allowing a sequence of
+// dependent calls to run successfully at block `blockNrOrHash`, or the latest
+// block if unspecified. Each call is estimated on top of the state produced by
+// the previous ones, e.g. a token swap following its approval. The overrides
+// apply to the state before the first call.
+func (api *BlockChainAPI) EstimateGasBundle(ctx context.Context, calls []TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides) ([]hexutil.Uint64, error) {
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	return DoEstimateGasBundle(ctx, api.b, calls, bNrOrHash, overrides, blockOverrides, api.b.RPCGasCap())
+}
+
 // RPCMarshalHeader converts the given header to the RPC output .
 func RPCMarshalHeader(head *types.Header) map[string]interface{} {
 	result := map[string]interface{}{
@@ -996,6 +1207,13 @@ type RPCTransaction struct {
 	R                   *hexutil.Big                 `json:"r"`
 	S                   *hexutil.Big                 `json:"s"`
 	YParity             *hexutil.Uint64              `json:"yParity,omitempty"`
+
+	tx *types.Transaction
+}
+
+// Transaction returns the transaction of the RPC representation.
+func (tx *RPCTransaction) Transaction() *types.Transaction {
+	return tx.tx
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -1017,6 +1235,7 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 		V:        (*hexutil.Big)(v),
 		R:        (*hexutil.Big)(r),
 		S:        (*hexutil.Big)(s),
+		tx:       tx,
 	}
 	if blockHash != (common.Hash{}) {
 		result.BlockHash = &blockHash
@@ -1543,15 +1762,9 @@ func (api *TransactionAPI) sign(addr common.Address, tx *types.Transaction) (*ty
 
 // SubmitTransaction is a helper function that submits tx to txPool and logs a message.
 func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction) (common.Hash, error) {
-	// If the transaction fee cap is already specified, ensure the
-	// fee of the given transaction is _reasonable_.
-	if err := checkTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
+	if err := checkSubmission(b, tx); err != nil {
 		return common.Hash{}, err
 	}
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
 	if err := b.SendTx(ctx, tx); err != nil {
 		return common.Hash{}, err
 	}
@@ -1572,6 +1785,20 @@ func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction) (c
 	return tx.Hash(), nil
 }
 
+// checkSubmission ensures a transaction is acceptable for submission over RPC.
+func checkSubmission(b Backend, tx *types.Transaction) error {
+	// If the transaction fee cap is already specified, ensure the
+	// fee of the given transaction is _reasonable_.
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
+		return err
+	}
+	if !b.UnprotectedAllowed() && !tx.Protected() {
+		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+		return errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+	return nil
+}
+
 // SendTransaction creates a transaction for the given argument, sign it and submit it to the
 // transaction pool.
 //
@@ -1638,6 +1865,24 @@ func (api *TransactionAPI) currentBlobSidecarVersion() byte {
 	return types.BlobSidecarVersion0
 }
 
+// convertLegacyBlobSidecar upgrades the proofs of a blob transaction's sidecar
+// to the version expected by the current fork, if it carries legacy ones.
+//
+// TODO: remove in go-ethereum v1.17.x
+func (api *TransactionAPI) convertLegacyBlobSidecar(tx *types.Transaction) (*types.Transaction, error) {
+	sc := tx.BlobTxSidecar()
+	if sc == nil {
+		return tx, nil
+	}
+	if sc.Version == types.BlobSidecarVersion0 && api.currentBlobSidecarVersion() == types.BlobSidecarVersion1 {
+		if err := sc.ToV1(); err != nil {
+			return nil, fmt.Errorf("blob sidecar conversion failed: %v", err)
+		}
+		tx = tx.WithBlobTxSidecar(sc)
+	}
+	return tx, nil
+}
+
 // SendRawTransaction will add the signed transaction to the transaction pool.
 // The sender is responsible for signing the transaction and using the correct nonce.
 func (api *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
@@ -1646,21 +1891,116 @@ func (api *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil
 		return common.Hash{}, err
 	}
 
-	// Convert legacy blob transaction proofs.
-	// TODO: remove in go-ethereum v1.17.x
-	if sc := tx.BlobTxSidecar(); sc != nil {
-		exp := api.currentBlobSidecarVersion()
-		if sc.Version == types.BlobSidecarVersion0 && exp == types.BlobSidecarVersion1 {
-			if err := sc.ToV1(); err != nil {
-				return common.Hash{}, fmt.Errorf("blob sidecar conversion failed: %v", err)
-			}
-			tx = tx.WithBlobTxSidecar(sc)
-		}
+	tx, err := api.convertLegacyBlobSidecar(tx)
+	if err != nil {
+		return common.Hash{}, err
 	}
 
 	return SubmitTransaction(ctx, api.b, tx)
 }
 
+// PrivateTxArgs represents the options of a private transaction submission.
+type PrivateTxArgs struct {
+	// MaxBlockNumber is the last block the transaction may be included in.
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+// SendPrivateRawTransaction will add the signed transaction to the transaction
+// pool for inclusion in locally built blocks, without ever propagating it to the
+// network. The transaction is dropped if it's not included until the requested
+// maximum block number, which defaults to defaultPrivateTxBlocks after the head.
+func (api *TransactionAPI) SendPrivateRawTransaction(ctx context.Context, input hexutil.Bytes, args *PrivateTxArgs) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := api.convertLegacyBlobSidecar(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := checkSubmission(api.b, tx); err != nil {
+		return common.Hash{}, err
+	}
+	maxBlock := api.b.CurrentBlock().Number.Uint64() + defaultPrivateTxBlocks
+	if args != nil && args.MaxBlockNumber != nil {
+		maxBlock = uint64(*args.MaxBlockNumber)
+	}
+	if err := api.b.SendPrivateTx(ctx, tx, maxBlock); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted private transaction", "hash", tx.Hash().Hex(), "nonce", tx.Nonce(), "maxblock", maxBlock)
+	return tx.Hash(), nil
+}
+
+// SendRawTransactionConditional will add the signed transaction to the transaction
+// pool, to be included only in blocks satisfying the given condition. The
+// transaction is dropped as soon as the condition can't hold anymore, and it is
+// never propagated to the network as peers can't enforce the condition.
+func (api *TransactionAPI) SendRawTransactionConditional(ctx context.Context, input hexutil.Bytes, options TransactionConditional) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	cond, err := options.toTxConditional()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := checkSubmission(api.b, tx); err != nil {
+		return common.Hash{}, err
+	}
+	if err := api.b.SendConditionalTx(ctx, tx, cond); err != nil {
+		if errors.Is(err, txpool.ErrConditionFailed) {
+			return common.Hash{}, &invalidTxError{Message: err.Error(), Code: errCodeTxConditionFailed}
+		}
+		return common.Hash{}, err
+	}
+	log.Info("Submitted conditional transaction", "hash", tx.Hash().Hex(), "nonce", tx.Nonce())
+	return tx.Hash(), nil
+}
+
+// SendBundleArgs represents the arguments of a transaction bundle submission.
+type SendBundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       *hexutil.Uint64 `json:"blockNumber"`
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes"`
+}
+
+// SendBundleResult is the result of a transaction bundle submission.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// SendBundle submits an ordered list of signed transactions for inclusion in
+// locally built blocks. The bundle is included as a whole in the requested block,
+// which defaults to the next one, ahead of any pool transactions, or not at all.
+// Transactions of the bundle may only revert if listed in revertingTxHashes.
+func (api *TransactionAPI) SendBundle(ctx context.Context, args SendBundleArgs) (*SendBundleResult, error) {
+	bundle := &bundlepool.Bundle{
+		Txs:               make([]*types.Transaction, len(args.Txs)),
+		BlockNumber:       api.b.CurrentBlock().Number.Uint64() + 1,
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	if args.BlockNumber != nil {
+		bundle.BlockNumber = uint64(*args.BlockNumber)
+	}
+	for i, input := range args.Txs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(input); err != nil {
+			return nil, fmt.Errorf("transaction %d: %v", i, err)
+		}
+		if err := checkSubmission(api.b, tx); err != nil {
+			return nil, fmt.Errorf("transaction %d: %v", i, err)
+		}
+		bundle.Txs[i] = tx
+	}
+	if err := api.b.SendBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+	hash := bundle.Hash()
+	log.Info("Submitted transaction bundle", "hash", hash.Hex(), "block", bundle.BlockNumber, "txs", len(bundle.Txs))
+	return &SendBundleResult{BundleHash: hash}, nil
+}
+
 // SendRawTransactionSync will add the signed transaction to the transaction pool
 // and wait until the transaction has been included in a block and return the receipt, or the timeout.
 func (api *TransactionAPI) SendRawTransactionSync(ctx context.Context, input hexutil.Bytes, timeoutMs *uint64) (map[string]interface{}, error) {
@@ -1669,16 +2009,9 @@ func (api *TransactionAPI) SendRawTransactionSync(ctx context.Context, input hex
 		return nil, err
 	}
 
-	// Convert legacy blob transaction proofs.
-	// TODO: remove in go-ethereum v1.17.x
-	if sc := tx.BlobTxSidecar(); sc != nil {
-		exp := api.currentBlobSidecarVersion()
-		if sc.Version == types.BlobSidecarVersion0 && exp == types.BlobSidecarVersion1 {
-			if err := sc.ToV1(); err != nil {
-				return nil, fmt.Errorf("blob sidecar conversion failed: %v", err)
-			}
-			tx = tx.WithBlobTxSidecar(sc)
-		}
+	tx, err := api.convertLegacyBlobSidecar(tx)
+	if err != nil {
+		return nil, err
 	}
 
 	ch := make(chan core.ChainEvent, 128)
@@ -47,10 +47,14 @@ import (
 	"github.com/ethereum/go-ethereum/core/filtermaps"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/internal/blocktest"
@@ -451,8 +455,13 @@ type testBackend struct {
 	chainFeed *event.Feed
 	autoMine  bool
 
-	sentTx     *types.Transaction
-	sentTxHash common.Hash
+	sentTx         *types.Transaction
+	sentTxHash     common.Hash
+	sentTxMaxBlock uint64                // Inclusion deadline of the last private transaction
+	sentTxCond     *txpool.TxConditional // Condition of the last conditional transaction
+	sentBundle     *bundlepool.Bundle    // Last submitted transaction bundle
+
+	blobSidecars map[common.Hash][]*blobpool.ArchivedSidecar
 
 	syncDefaultTimeout time.Duration
 	syncMaxTimeout     time.Duration
@@ -500,6 +509,9 @@ func (b testBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 func (b testBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, []*big.Int, []float64, error) {
 	return nil, nil, nil, nil, nil, nil, nil
 }
+func (b testBackend) FeeEstimates(ctx context.Context) ([]gasprice.FeeEstimate, error) {
+	return nil, nil
+}
 func (b testBackend) BlobBaseFee(ctx context.Context) *big.Int { return new(big.Int) }
 func (b testBackend) ChainDb() ethdb.Database                  { return b.db }
 func (b testBackend) AccountManager() *accounts.Manager        { return b.accman }
@@ -589,6 +601,9 @@ func (b testBackend) Pending() (*types.Block, types.Receipts, *state.StateDB) {
 	}
 	return block, b.pendingReceipts, nil
 }
+func (b testBackend) GetBlobSidecars(ctx context.Context, block *types.Block) ([]*blobpool.ArchivedSidecar, error) {
+	return b.blobSidecars[block.Hash()], nil
+}
 func (b testBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
 	header, err := b.HeaderByHash(ctx, hash)
 	if header == nil || err != nil {
@@ -613,6 +628,22 @@ func (b testBackend) SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscr
 func (b testBackend) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
 	panic("implement me")
 }
+func (b *testBackend) SendPrivateTx(ctx context.Context, tx *types.Transaction, maxBlock uint64) error {
+	b.sentTx = tx
+	b.sentTxHash = tx.Hash()
+	b.sentTxMaxBlock = maxBlock
+	return nil
+}
+func (b *testBackend) SendConditionalTx(ctx context.Context, tx *types.Transaction, cond *txpool.TxConditional) error {
+	b.sentTx = tx
+	b.sentTxHash = tx.Hash()
+	b.sentTxCond = cond
+	return nil
+}
+func (b *testBackend) SendBundle(ctx context.Context, bundle *bundlepool.Bundle) error {
+	b.sentBundle = bundle
+	return nil
+}
 func (b *testBackend) SendTx(ctx context.Context, tx *types.Transaction) error {
 	b.sentTx = tx
 	b.sentTxHash = tx.Hash()
@@ -680,12 +711,18 @@ func (b testBackend) Stats() (pending int, queued int) { panic("implement me") }
 func (b testBackend) TxPoolContent() (map[common.Address][]*types.Transaction, map[common.Address][]*types.Transaction) {
 	panic("implement me")
 }
+func (b testBackend) TxPoolDiagnose(addr common.Address) *txpool.AccountDiagnosis {
+	panic("implement me")
+}
 func (b testBackend) TxPoolContentFrom(addr common.Address) ([]*types.Transaction, []*types.Transaction) {
 	panic("implement me")
 }
 func (b testBackend) SubscribeNewTxsEvent(events chan<- core.NewTxsEvent) event.Subscription {
 	panic("implement me")
 }
+func (b testBackend) SubscribeTxPoolEvents(events chan<- []txpool.TxEvent) event.Subscription {
+	panic("implement me")
+}
 func (b testBackend) ChainConfig() *params.ChainConfig { return b.chain.Config() }
 func (b testBackend) Engine() consensus.Engine         { return b.chain.Engine() }
 func (b testBackend) GetLogs(ctx context.Context, blockHash common.Hash, number uint64) ([][]*types.Log, error) {
@@ -998,6 +1035,72 @@ func TestEstimateGas(t *testing.T) {
 	}
 }
 
+func TestEstimateGasBundle(t *testing.T) {
+	t.Parallel()
+
+	// The contract sets its first storage slot if called with data, and reverts
+	// on calls without data unless the slot is set.
+	var (
+		accounts = newAccounts(1)
+		contract = common.HexToAddress("0xc0de")
+		code     = common.FromHex("0x36600e576000546014576000" + "80fd" + "5b6001600055" + "5b00")
+		genesis  = &core.Genesis{
+			Config: params.MergedTestChainConfig,
+			Alloc: types.GenesisAlloc{
+				accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+				contract:         {Code: code},
+			},
+		}
+		latest = rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	)
+	api := NewBlockChainAPI(newTestBackend(t, 1, genesis, beacon.New(ethash.NewFaker()), func(i int, b *core.BlockGen) {
+		b.SetPoS()
+	}))
+	set := TransactionArgs{From: &accounts[0].addr, To: &contract, Input: &hexutil.Bytes{0x01}}
+	check := TransactionArgs{From: &accounts[0].addr, To: &contract}
+
+	// The dependent call fails on its own, but succeeds after the first one
+	if _, err := api.EstimateGas(context.Background(), check, &latest, nil, nil); err == nil {
+		t.Fatal("dependent call estimated on its own")
+	}
+	want, err := api.EstimateGas(context.Background(), set, &latest, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to estimate call: %v", err)
+	}
+	estimates, err := api.EstimateGasBundle(context.Background(), []TransactionArgs{set, check}, &latest, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to estimate bundle: %v", err)
+	}
+	if len(estimates) != 2 {
+		t.Fatalf("estimate count mismatch: have %d, want 2", len(estimates))
+	}
+	if estimates[0] != want {
+		t.Errorf("first estimate mismatch: have %d, want %d", estimates[0], want)
+	}
+	if uint64(estimates[1]) <= params.TxGas {
+		t.Errorf("second estimate too low: %d", estimates[1])
+	}
+	// Failures report the index of the failing call
+	_, err = api.EstimateGasBundle(context.Background(), []TransactionArgs{check, set}, &latest, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "call 0") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rerr, ok := err.(*revertError); !ok || rerr.ErrorCode() != 3 {
+		t.Errorf("expected revert error, got %T", err)
+	}
+	// The gas cap is shared by all calls of the bundle
+	gasCap := uint64(want) + params.TxGas
+	_, err = DoEstimateGasBundle(context.Background(), api.b, []TransactionArgs{set, check}, latest, nil, nil, gasCap)
+	if err == nil || !strings.Contains(err.Error(), "call 1") {
+		t.Fatalf("expected bundle to exceed the gas cap, got %v", err)
+	}
+	// Oversized bundles are rejected
+	calls := make([]TransactionArgs, maxEstimateGasBundleCalls+1)
+	if _, err := api.EstimateGasBundle(context.Background(), calls, &latest, nil, nil); err == nil {
+		t.Fatal("expected oversized bundle to be rejected")
+	}
+}
+
 func TestCall(t *testing.T) {
 	t.Parallel()
 
@@ -3636,6 +3739,55 @@ func TestRPCGetTransactionReceipt(t *testing.T) {
 	}
 }
 
+func TestGetBlobSidecars(t *testing.T) {
+	t.Parallel()
+
+	genesis := &core.Genesis{
+		Config: params.MergedTestChainConfig,
+		Alloc:  types.GenesisAlloc{},
+	}
+	backend := newTestBackend(t, 2, genesis, beacon.New(ethash.NewFaker()), func(i int, b *core.BlockGen) {
+		b.SetPoS()
+	})
+	var (
+		api        = NewBlockChainAPI(backend)
+		block      = backend.chain.GetBlockByNumber(1)
+		blob       = new(kzg4844.Blob)
+		commit, _  = kzg4844.BlobToCommitment(blob)
+		proof, _   = kzg4844.ComputeBlobProof(blob, commit)
+		sidecar    = types.NewBlobTxSidecar(types.BlobSidecarVersion0, []kzg4844.Blob{*blob}, []kzg4844.Commitment{commit}, []kzg4844.Proof{proof})
+		txHash     = common.Hash{0x01}
+		unknown, _ = api.GetBlobSidecars(context.Background(), common.Hash{0xff})
+	)
+	if unknown != nil {
+		t.Fatalf("sidecars returned for unknown block: %v", unknown)
+	}
+	backend.blobSidecars = map[common.Hash][]*blobpool.ArchivedSidecar{
+		block.Hash(): {{TxHash: txHash, TxIndex: 2, Sidecar: sidecar}},
+	}
+	results, err := api.GetBlobSidecars(context.Background(), block.Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve sidecars: %v", err)
+	}
+	want := []*blobSidecarResult{{
+		BlockHash:        block.Hash(),
+		BlockNumber:      1,
+		TransactionHash:  txHash,
+		TransactionIndex: 2,
+		Blobs:            sidecar.Blobs,
+		Commitments:      sidecar.Commitments,
+		Proofs:           sidecar.Proofs,
+	}}
+	if !reflect.DeepEqual(results, want) {
+		t.Fatalf("sidecar mismatch: have %v, want %v", results, want)
+	}
+	// Blocks without archived sidecars return an empty list
+	results, err = api.GetBlobSidecars(context.Background(), backend.chain.GetBlockByNumber(2).Hash())
+	if err != nil || results == nil || len(results) != 0 {
+		t.Fatalf("unexpected result for block without sidecars: %v, %v", results, err)
+	}
+}
+
 func TestRPCGetBlockReceipts(t *testing.T) {
 	t.Parallel()
 
@@ -4023,6 +4175,101 @@ func TestSendRawTransactionSync_Success(t *testing.T) {
 	}
 }
 
+func TestSendRawTransactionConditional(t *testing.T) {
+	t.Parallel()
+
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc:  types.GenesisAlloc{},
+	}
+	b := newTestBackend(t, 1, genesis, ethash.NewFaker(), nil)
+	api := NewTransactionAPI(b, new(AddrLocker))
+	raw, tx := makeSelfSignedRaw(t, api, b.acc.Address)
+
+	var options TransactionConditional
+	input := `{
+		"knownAccounts": {
+			"0x0000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002",
+			"0x0000000000000000000000000000000000000003": {"0x0000000000000000000000000000000000000000000000000000000000000004": "0x0000000000000000000000000000000000000000000000000000000000000005"}
+		},
+		"blockNumberMax": "0x10",
+		"timestampMin": "0x1"
+	}`
+	if err := json.Unmarshal([]byte(input), &options); err != nil {
+		t.Fatalf("failed to decode condition: %v", err)
+	}
+	hash, err := api.SendRawTransactionConditional(context.Background(), raw, options)
+	if err != nil {
+		t.Fatalf("failed to send conditional transaction: %v", err)
+	}
+	if hash != tx.Hash() || b.sentTxHash != tx.Hash() {
+		t.Fatalf("transaction hash mismatch")
+	}
+	cond := b.sentTxCond
+	if cond == nil {
+		t.Fatal("condition not submitted to backend")
+	}
+	if *cond.BlockNumberMax != 0x10 || *cond.TimestampMin != 1 || cond.BlockNumberMin != nil || cond.TimestampMax != nil {
+		t.Errorf("bounds mismatch: %+v", cond)
+	}
+	want := map[common.Address]txpool.KnownAccount{
+		common.HexToAddress("0x01"): {StorageRoot: &common.Hash{31: 0x02}},
+		common.HexToAddress("0x03"): {StorageSlots: map[common.Hash]common.Hash{{31: 0x04}: {31: 0x05}}},
+	}
+	if !reflect.DeepEqual(cond.KnownAccounts, want) {
+		t.Errorf("known accounts mismatch: have %v, want %v", cond.KnownAccounts, want)
+	}
+	// Conditions which can never hold are rejected
+	minBlock := hexutil.Uint64(0x11)
+	options.BlockNumberMin = &minBlock
+	if _, err := api.SendRawTransactionConditional(context.Background(), raw, options); err == nil {
+		t.Error("empty block range accepted")
+	}
+}
+
+func TestSendBundle(t *testing.T) {
+	t.Parallel()
+
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc:  types.GenesisAlloc{},
+	}
+	b := newTestBackend(t, 1, genesis, ethash.NewFaker(), nil)
+	api := NewTransactionAPI(b, new(AddrLocker))
+
+	raw1, tx1 := makeSignedRaw(t, api, b.acc.Address, common.Address{0x01}, big.NewInt(1))
+	raw2, tx2 := makeSignedRaw(t, api, b.acc.Address, common.Address{0x02}, big.NewInt(2))
+
+	// The bundle targets the next block by default
+	res, err := api.SendBundle(context.Background(), SendBundleArgs{
+		Txs:               []hexutil.Bytes{raw1, raw2},
+		RevertingTxHashes: []common.Hash{tx2.Hash()},
+	})
+	if err != nil {
+		t.Fatalf("failed to send bundle: %v", err)
+	}
+	bundle := b.sentBundle
+	if bundle == nil {
+		t.Fatal("bundle not submitted to backend")
+	}
+	if res.BundleHash != bundle.Hash() {
+		t.Errorf("bundle hash mismatch: have %x, want %x", res.BundleHash, bundle.Hash())
+	}
+	if bundle.BlockNumber != 2 {
+		t.Errorf("target block mismatch: have %d, want %d", bundle.BlockNumber, 2)
+	}
+	if len(bundle.Txs) != 2 || bundle.Txs[0].Hash() != tx1.Hash() || bundle.Txs[1].Hash() != tx2.Hash() {
+		t.Errorf("bundle transactions mismatch")
+	}
+	if !bundle.MayRevert(tx2.Hash()) || bundle.MayRevert(tx1.Hash()) {
+		t.Errorf("reverting transactions mismatch")
+	}
+	// Malformed transactions are rejected
+	if _, err := api.SendBundle(context.Background(), SendBundleArgs{Txs: []hexutil.Bytes{raw1, {0x01}}}); err == nil {
+		t.Error("malformed bundle accepted")
+	}
+}
+
 func TestSendRawTransactionSync_Timeout(t *testing.T) {
 	t.Parallel()
 
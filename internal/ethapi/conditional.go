@@ -0,0 +1,108 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/txpool"
+)
+
+// maxConditionalCost is the maximum number of storage roots and slots a single
+// transaction condition may refer to.
+const maxConditionalCost = 1000
+
+// KnownAccount is the expected storage of an account, given either as the storage
+// root hash, or as an object mapping storage slots to their values.
+type KnownAccount struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *KnownAccount) UnmarshalJSON(input []byte) error {
+	var root common.Hash
+	if err := json.Unmarshal(input, &root); err == nil {
+		a.StorageRoot, a.StorageSlots = &root, nil
+		return nil
+	}
+	var slots map[common.Hash]common.Hash
+	if err := json.Unmarshal(input, &slots); err != nil {
+		return errors.New("known account must be a storage root or an object of storage slots")
+	}
+	a.StorageRoot, a.StorageSlots = nil, slots
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a KnownAccount) MarshalJSON() ([]byte, error) {
+	if a.StorageRoot != nil {
+		return json.Marshal(a.StorageRoot)
+	}
+	return json.Marshal(a.StorageSlots)
+}
+
+// TransactionConditional represents the conditions a transaction submitted with
+// eth_sendRawTransactionConditional is only valid under.
+type TransactionConditional struct {
+	KnownAccounts  map[common.Address]KnownAccount `json:"knownAccounts"`
+	BlockNumberMin *hexutil.Uint64                 `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Uint64                 `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                 `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                 `json:"timestampMax,omitempty"`
+}
+
+// cost returns the number of storage roots and slots the condition refers to.
+func (c *TransactionConditional) cost() int {
+	var cost int
+	for _, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			cost++
+		} else {
+			cost += len(account.StorageSlots)
+		}
+	}
+	return cost
+}
+
+// toTxConditional converts the arguments to the condition enforced by the pool.
+func (c *TransactionConditional) toTxConditional() (*txpool.TxConditional, error) {
+	if cost := c.cost(); cost > maxConditionalCost {
+		return nil, &clientLimitExceededError{message: fmt.Sprintf("condition cost %d exceeds maximum of %d", cost, maxConditionalCost)}
+	}
+	cond := &txpool.TxConditional{
+		KnownAccounts:  make(map[common.Address]txpool.KnownAccount, len(c.KnownAccounts)),
+		BlockNumberMin: (*uint64)(c.BlockNumberMin),
+		BlockNumberMax: (*uint64)(c.BlockNumberMax),
+		TimestampMin:   (*uint64)(c.TimestampMin),
+		TimestampMax:   (*uint64)(c.TimestampMax),
+	}
+	for addr, account := range c.KnownAccounts {
+		cond.KnownAccounts[addr] = txpool.KnownAccount{
+			StorageRoot:  account.StorageRoot,
+			StorageSlots: account.StorageSlots,
+		}
+	}
+	if err := cond.Validate(); err != nil {
+		return nil, &invalidParamsError{message: err.Error()}
+	}
+	return cond, nil
+}
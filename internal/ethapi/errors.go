@@ -114,6 +114,7 @@ const (
 	errCodeInvalidParams           = -32602
 	errCodeVMError                 = -32015
 	errCodeTxSyncTimeout           = 4
+	errCodeTxConditionFailed       = -32003
 )
 
 func txValidationError(err error) *invalidTxError {
@@ -709,6 +709,11 @@ web3._extend({
 			call: 'txpool_contentFrom',
 			params: 1,
 		}),
+		new web3._extend.Method({
+			name: 'diagnose',
+			call: 'txpool_diagnose',
+			params: 1,
+		}),
 	]
 });
 `
@@ -0,0 +1,98 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// commitBundles simulates the bundles targeting the block being built on top of
+// the current environment, including each of them as a whole if all of its
+// transactions succeed, and discarding it otherwise.
+func (miner *Miner) commitBundles(env *environment, interrupt *atomic.Int32) error {
+	if miner.bundles == nil {
+		return nil
+	}
+	for _, bundle := range miner.bundles.Bundles(env.header.Number.Uint64()) {
+		if interrupt != nil {
+			if signal := interrupt.Load(); signal != commitInterruptNone {
+				return signalToErr(signal)
+			}
+		}
+		if err := miner.commitBundle(env, bundle); err != nil {
+			log.Debug("Bundle discarded", "hash", bundle.Hash(), "err", err)
+		}
+	}
+	return nil
+}
+
+// commitBundle applies all transactions of a bundle in order. If any of them is
+// invalid, or reverts without being allowed to, the environment is rolled back
+// to its state before the bundle.
+func (miner *Miner) commitBundle(env *environment, bundle *bundlepool.Bundle) error {
+	if env.gasPool == nil {
+		env.gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+	}
+	// State snapshots don't survive transaction boundaries, back up the entire
+	// state instead.
+	var (
+		backup  = env.state.Copy()
+		gas     = env.gasPool.Gas()
+		gasUsed = env.header.GasUsed
+		txs     = len(env.txs)
+		size    = env.size
+		tcount  = env.tcount
+	)
+	for _, tx := range bundle.Txs {
+		err := miner.commitBundleTx(env, bundle, tx)
+		if err == nil {
+			continue
+		}
+		env.state, env.evm.StateDB, env.witness = backup, backup, backup.Witness()
+		env.gasPool.SetGas(gas)
+		env.header.GasUsed = gasUsed
+		env.txs, env.receipts = env.txs[:txs], env.receipts[:txs]
+		env.size, env.tcount = size, tcount
+		return err
+	}
+	return nil
+}
+
+// commitBundleTx applies a single transaction of a bundle.
+func (miner *Miner) commitBundleTx(env *environment, bundle *bundlepool.Bundle, tx *types.Transaction) error {
+	if !env.txFitsSize(tx) {
+		return errors.New("bundle exceeds block size")
+	}
+	if tx.Protected() && !miner.chainConfig.IsEIP155(env.header.Number) {
+		return fmt.Errorf("replay protected transaction %x before EIP-155", tx.Hash())
+	}
+	env.state.SetTxContext(tx.Hash(), env.tcount)
+	if err := miner.commitTransaction(env, tx); err != nil {
+		return fmt.Errorf("transaction %x failed: %w", tx.Hash(), err)
+	}
+	if receipt := env.receipts[len(env.receipts)-1]; receipt.Status == types.ReceiptStatusFailed && !bundle.MayRevert(tx.Hash()) {
+		return fmt.Errorf("transaction %x reverted", tx.Hash())
+	}
+	return nil
+}
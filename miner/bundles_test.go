@@ -0,0 +1,107 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that bundles are included atomically ahead of the pool transactions.
+func TestCommitBundles(t *testing.T) {
+	w, b := newTestWorker(t, params.TestChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+
+	signer := types.LatestSigner(params.TestChainConfig)
+	transfer := func(nonce uint64, to common.Address, gas uint64) *types.Transaction {
+		return types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       &to,
+			Value:    big.NewInt(1),
+			Gas:      gas,
+			GasPrice: big.NewInt(params.InitialBaseFee),
+		})
+	}
+	build := func() []*types.Transaction {
+		t.Helper()
+
+		r := w.generateWork(&generateParams{
+			timestamp:  uint64(time.Now().Unix()),
+			parentHash: b.chain.CurrentBlock().Hash(),
+			coinbase:   testBankAddress,
+		}, false)
+		if r.err != nil {
+			t.Fatalf("failed to generate work: %v", r.err)
+		}
+		return r.block.Transactions()
+	}
+	check := func(have []*types.Transaction, want ...*types.Transaction) {
+		t.Helper()
+
+		if len(have) != len(want) {
+			t.Fatalf("transaction count mismatch: have %d, want %d", len(have), len(want))
+		}
+		for i := range want {
+			if have[i].Hash() != want[i].Hash() {
+				t.Errorf("transaction %d mismatch: have %x, want %x", i, have[i].Hash(), want[i].Hash())
+			}
+		}
+	}
+	// A bundle with a nonce gap is discarded as a whole
+	invalid := &bundlepool.Bundle{
+		Txs:         []*types.Transaction{transfer(0, testUserAddress, params.TxGas), transfer(2, testUserAddress, params.TxGas)},
+		BlockNumber: 1,
+	}
+	if err := b.bundles.Add(invalid); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	check(build(), pendingTxs...)
+
+	// A reverting transaction discards the bundle, unless it's allowed to revert
+	reverting := transfer(1, testRevertAddress, 100000)
+	bundle := &bundlepool.Bundle{
+		Txs:         []*types.Transaction{transfer(0, testUserAddress, params.TxGas), reverting},
+		BlockNumber: 1,
+	}
+	if err := b.bundles.Add(bundle); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	check(build(), pendingTxs...)
+
+	allowed := &bundlepool.Bundle{
+		Txs:               []*types.Transaction{bundle.Txs[0], reverting, transfer(2, testUserAddress, params.TxGas)},
+		BlockNumber:       1,
+		RevertingTxHashes: []common.Hash{reverting.Hash()},
+	}
+	if err := b.bundles.Add(allowed); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	// The pool transaction conflicts with the bundle by nonce and is skipped
+	check(build(), allowed.Txs...)
+
+	// Bundles targeting past blocks are rejected
+	if err := b.bundles.Add(&bundlepool.Bundle{Txs: allowed.Txs, BlockNumber: 0}); err != bundlepool.ErrBundleStale {
+		t.Errorf("stale bundle error mismatch: have %v, want %v", err, bundlepool.ErrBundleStale)
+	}
+}
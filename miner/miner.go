@@ -29,7 +29,9 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -38,6 +40,7 @@ import (
 type Backend interface {
 	BlockChain() *core.BlockChain
 	TxPool() *txpool.TxPool
+	BundlePool() *bundlepool.BundlePool
 }
 
 // Config is the configuration parameters of mining.
@@ -49,6 +52,9 @@ type Config struct {
 	GasPrice            *big.Int       // Minimum gas price for mining a transaction
 	Recommit            time.Duration  // The time interval for miner to re-create mining work.
 	MaxBlobsPerBlock    int            // Maximum number of blobs per block (0 for unset uses protocol default)
+
+	Ordering          string           `toml:",omitempty"` // Transaction ordering policy: "price" (default), "fifo" or "roundrobin"
+	PriorityAddresses []common.Address `toml:",omitempty"` // Senders whose transactions are included before all others
 }
 
 // DefaultConfig contains default settings for miner.
@@ -71,7 +77,9 @@ type Miner struct {
 	chainConfig *params.ChainConfig
 	engine      consensus.Engine
 	txpool      *txpool.TxPool
-	prio        []common.Address // A list of senders to prioritize
+	bundles     *bundlepool.BundlePool // Bundles to include atomically, may be nil
+	prio        []common.Address       // A list of senders to prioritize
+	ordering    OrderingPolicy         // Policy deciding the order of transactions in blocks
 	chain       *core.BlockChain
 	pending     *pending
 	pendingMu   sync.Mutex // Lock protects the pending block
@@ -79,11 +87,18 @@ type Miner struct {
 
 // New creates a new miner with provided config.
 func New(eth Backend, config Config, engine consensus.Engine) *Miner {
+	ordering, err := LookupOrderingPolicy(config.Ordering)
+	if err != nil {
+		log.Warn("Falling back to default transaction ordering", "err", err)
+		ordering, _ = LookupOrderingPolicy(OrderingPriceNonce)
+	}
 	return &Miner{
 		config:      &config,
+		ordering:    ordering,
 		chainConfig: eth.BlockChain().Config(),
 		engine:      engine,
 		txpool:      eth.TxPool(),
+		bundles:     eth.BundlePool(),
 		chain:       eth.BlockChain(),
 		pending:     &pending{},
 	}
@@ -118,6 +133,13 @@ func (miner *Miner) SetPrioAddresses(prio []common.Address) {
 	miner.confMu.Unlock()
 }
 
+// SetOrderingPolicy sets the policy deciding the order of transactions in blocks.
+func (miner *Miner) SetOrderingPolicy(ordering OrderingPolicy) {
+	miner.confMu.Lock()
+	miner.ordering = ordering
+	miner.confMu.Unlock()
+}
+
 // SetGasCeil sets the gaslimit to strive for when mining blocks post 1559.
 // For pre-1559 blocks, it sets the ceiling.
 func (miner *Miner) SetGasCeil(ceil uint64) {
@@ -105,16 +105,7 @@ func newTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address]
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
 	// Initialize a price and received time based heap with the head transactions
-	heads := make(txByPriceAndTime, 0, len(txs))
-	for from, accTxs := range txs {
-		wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFeeUint)
-		if err != nil {
-			delete(txs, from)
-			continue
-		}
-		heads = append(heads, wrapped)
-		txs[from] = accTxs[1:]
-	}
+	heads := txByPriceAndTime(splitHeads(txs, baseFeeUint))
 	heap.Init(&heads)
 
 	// Assemble and return the transaction set
@@ -136,13 +127,10 @@ func (t *transactionsByPriceAndNonce) Peek() (*txpool.LazyTransaction, *uint256.
 
 // Shift replaces the current best head with the next one from the same account.
 func (t *transactionsByPriceAndNonce) Shift() {
-	acc := t.heads[0].from
-	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
-		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
-			t.heads[0], t.txs[acc] = wrapped, txs[1:]
-			heap.Fix(&t.heads, 0)
-			return
-		}
+	if next := nextHead(t.txs, t.heads[0].from, t.baseFee); next != nil {
+		t.heads[0] = next
+		heap.Fix(&t.heads, 0)
+		return
 	}
 	heap.Pop(&t.heads)
 }
@@ -0,0 +1,286 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// Names of the built-in transaction ordering policies.
+const (
+	OrderingPriceNonce = "price"      // by effective miner tip, the default
+	OrderingFIFO       = "fifo"       // by arrival time in the transaction pool
+	OrderingRoundRobin = "roundrobin" // one transaction per sender and round
+)
+
+// OrderingPolicy decides the order in which the pending transactions are included
+// into a block. Transactions of the same sender are always handed out in nonce
+// order, regardless of the policy.
+type OrderingPolicy interface {
+	// NewSet creates an ordered set from the pending transactions of each sender.
+	// The set takes ownership of the input map.
+	NewSet(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) TransactionSet
+}
+
+// TransactionSet is a set of transactions handed out in the order of a policy.
+// Plain and blob transactions are kept in separate sets while building a block,
+// the priority reported by Peek decides which of the two heads goes first.
+type TransactionSet interface {
+	// Peek returns the next transaction along with its priority. Transactions
+	// with higher priority are included first.
+	Peek() (*txpool.LazyTransaction, *uint256.Int)
+
+	// Shift replaces the current head with the next transaction of the same sender.
+	Shift()
+
+	// Pop removes the current head, discarding all remaining transactions of the
+	// same sender.
+	Pop()
+
+	// Empty returns whether the set has no transactions left.
+	Empty() bool
+
+	// Clear removes all transactions from the set.
+	Clear()
+}
+
+// orderingPolicyFunc adapts a set constructor to the OrderingPolicy interface.
+type orderingPolicyFunc func(types.Signer, map[common.Address][]*txpool.LazyTransaction, *big.Int) TransactionSet
+
+func (f orderingPolicyFunc) NewSet(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) TransactionSet {
+	return f(signer, txs, baseFee)
+}
+
+var orderingPolicies = map[string]OrderingPolicy{
+	OrderingPriceNonce: orderingPolicyFunc(func(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) TransactionSet {
+		return newTransactionsByPriceAndNonce(signer, txs, baseFee)
+	}),
+	OrderingFIFO: orderingPolicyFunc(func(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) TransactionSet {
+		return newTransactionsByTimeAndNonce(txs, baseFee)
+	}),
+	OrderingRoundRobin: orderingPolicyFunc(func(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) TransactionSet {
+		return newTransactionsByRoundRobin(txs, baseFee)
+	}),
+}
+
+// LookupOrderingPolicy returns the built-in ordering policy with the given name.
+// The empty name selects the default price and nonce ordering.
+func LookupOrderingPolicy(name string) (OrderingPolicy, error) {
+	if name == "" {
+		name = OrderingPriceNonce
+	}
+	policy, ok := orderingPolicies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown transaction ordering policy %q", name)
+	}
+	return policy, nil
+}
+
+// splitHeads wraps the first transaction of every sender, dropping the senders
+// whose transactions can't pay the base fee.
+func splitHeads(txs map[common.Address][]*txpool.LazyTransaction, baseFee *uint256.Int) []*txWithMinerFee {
+	heads := make([]*txWithMinerFee, 0, len(txs))
+	for from, accTxs := range txs {
+		wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFee)
+		if err != nil {
+			delete(txs, from)
+			continue
+		}
+		heads = append(heads, wrapped)
+		txs[from] = accTxs[1:]
+	}
+	return heads
+}
+
+// nextHead wraps the next transaction of the given sender, or returns nil if the
+// sender has no executable transactions left.
+func nextHead(txs map[common.Address][]*txpool.LazyTransaction, from common.Address, baseFee *uint256.Int) *txWithMinerFee {
+	if accTxs, ok := txs[from]; ok && len(accTxs) > 0 {
+		if wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFee); err == nil {
+			txs[from] = accTxs[1:]
+			return wrapped
+		}
+	}
+	return nil
+}
+
+// txByTime implements the heap interface, ordering transactions by the time they
+// were first seen.
+type txByTime []*txWithMinerFee
+
+func (s txByTime) Len() int { return len(s) }
+func (s txByTime) Less(i, j int) bool {
+	if !s[i].tx.Time.Equal(s[j].tx.Time) {
+		return s[i].tx.Time.Before(s[j].tx.Time)
+	}
+	// Transactions seen at the same time are ordered by price
+	return s[i].fees.Gt(s[j].fees)
+}
+func (s txByTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txByTime) Push(x interface{}) {
+	*s = append(*s, x.(*txWithMinerFee))
+}
+
+func (s *txByTime) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*s = old[0 : n-1]
+	return x
+}
+
+// transactionsByTimeAndNonce is a transaction set handing out transactions in the
+// order they arrived in the transaction pool.
+type transactionsByTimeAndNonce struct {
+	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
+	heads   txByTime                                     // Next transaction for each unique account (time heap)
+	baseFee *uint256.Int                                 // Current base fee
+}
+
+// newTransactionsByTimeAndNonce creates a transaction set that can retrieve the
+// transactions in arrival order in a nonce-honouring way.
+func newTransactionsByTimeAndNonce(txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *transactionsByTimeAndNonce {
+	var baseFeeUint *uint256.Int
+	if baseFee != nil {
+		baseFeeUint = uint256.MustFromBig(baseFee)
+	}
+	heads := txByTime(splitHeads(txs, baseFeeUint))
+	heap.Init(&heads)
+
+	return &transactionsByTimeAndNonce{
+		txs:     txs,
+		heads:   heads,
+		baseFee: baseFeeUint,
+	}
+}
+
+// Peek returns the transaction seen earliest. Its priority decreases with the
+// arrival time.
+func (t *transactionsByTimeAndNonce) Peek() (*txpool.LazyTransaction, *uint256.Int) {
+	if len(t.heads) == 0 {
+		return nil, nil
+	}
+	head := t.heads[0]
+	return head.tx, new(uint256.Int).SetUint64(math.MaxUint64 - uint64(head.tx.Time.UnixNano()))
+}
+
+// Shift replaces the current head with the next one from the same account.
+func (t *transactionsByTimeAndNonce) Shift() {
+	if next := nextHead(t.txs, t.heads[0].from, t.baseFee); next != nil {
+		t.heads[0] = next
+		heap.Fix(&t.heads, 0)
+		return
+	}
+	heap.Pop(&t.heads)
+}
+
+// Pop removes the current head without replacing it.
+func (t *transactionsByTimeAndNonce) Pop() {
+	heap.Pop(&t.heads)
+}
+
+// Empty returns if the set is empty.
+func (t *transactionsByTimeAndNonce) Empty() bool {
+	return len(t.heads) == 0
+}
+
+// Clear removes the entire content of the set.
+func (t *transactionsByTimeAndNonce) Clear() {
+	t.heads, t.txs = nil, nil
+}
+
+// transactionsByRoundRobin is a transaction set handing out one transaction of
+// every sender per round, such that no sender can monopolize the block. Within
+// a round, senders are served by the price of their next transaction.
+type transactionsByRoundRobin struct {
+	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
+	current []*txWithMinerFee                            // Senders yet to be served in the current round
+	next    []*txWithMinerFee                            // Senders to be served in the next round
+	round   uint64                                       // Number of the current round
+	baseFee *uint256.Int                                 // Current base fee
+}
+
+// newTransactionsByRoundRobin creates a transaction set that can retrieve the
+// transactions round-robin by sender in a nonce-honouring way.
+func newTransactionsByRoundRobin(txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *transactionsByRoundRobin {
+	var baseFeeUint *uint256.Int
+	if baseFee != nil {
+		baseFeeUint = uint256.MustFromBig(baseFee)
+	}
+	current := splitHeads(txs, baseFeeUint)
+	sort.Sort(txByPriceAndTime(current))
+
+	return &transactionsByRoundRobin{
+		txs:     txs,
+		current: current,
+		baseFee: baseFeeUint,
+	}
+}
+
+// Peek returns the next transaction of the current round. Its priority decreases
+// with the round.
+func (t *transactionsByRoundRobin) Peek() (*txpool.LazyTransaction, *uint256.Int) {
+	if len(t.current) == 0 {
+		return nil, nil
+	}
+	return t.current[0].tx, new(uint256.Int).SetUint64(math.MaxUint64 - t.round)
+}
+
+// Shift queues the next transaction of the current sender for the next round.
+func (t *transactionsByRoundRobin) Shift() {
+	if next := nextHead(t.txs, t.current[0].from, t.baseFee); next != nil {
+		t.next = append(t.next, next)
+	}
+	t.advance()
+}
+
+// Pop removes the current sender from all subsequent rounds.
+func (t *transactionsByRoundRobin) Pop() {
+	t.advance()
+}
+
+// advance moves to the next sender, starting a new round if all senders of the
+// current one were served.
+func (t *transactionsByRoundRobin) advance() {
+	t.current = t.current[1:]
+	if len(t.current) == 0 && len(t.next) > 0 {
+		t.current, t.next = t.next, nil
+		sort.Sort(txByPriceAndTime(t.current))
+		t.round++
+	}
+}
+
+// Empty returns if the set is empty.
+func (t *transactionsByRoundRobin) Empty() bool {
+	return len(t.current) == 0
+}
+
+// Clear removes the entire content of the set.
+func (t *transactionsByRoundRobin) Clear() {
+	t.current, t.next, t.txs = nil, nil, nil
+}
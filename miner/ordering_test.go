@@ -194,3 +194,116 @@ func TestTransactionTimeSort(t *testing.T) {
 		}
 	}
 }
+
+// makeLazyTxs signs a nonce-ordered list of transactions for the given key, with
+// the given gas prices and arrival times.
+func makeLazyTxs(t *testing.T, key *ecdsa.PrivateKey, prices []int64, times []int64) []*txpool.LazyTransaction {
+	var txs []*txpool.LazyTransaction
+	for i := range prices {
+		tx, err := types.SignTx(types.NewTransaction(uint64(i), common.Address{}, big.NewInt(100), 100, big.NewInt(prices[i]), nil), types.HomesteadSigner{}, key)
+		if err != nil {
+			t.Fatalf("failed to sign tx: %v", err)
+		}
+		tx.SetTime(time.Unix(0, times[i]))
+		txs = append(txs, &txpool.LazyTransaction{
+			Hash:      tx.Hash(),
+			Tx:        tx,
+			Time:      tx.Time(),
+			GasFeeCap: uint256.MustFromBig(tx.GasFeeCap()),
+			GasTipCap: uint256.MustFromBig(tx.GasTipCap()),
+			Gas:       tx.Gas(),
+		})
+	}
+	return txs
+}
+
+// drainSet collects the transactions of a set, shifting after every one of them.
+func drainSet(set TransactionSet) []*types.Transaction {
+	var txs []*types.Transaction
+	for tx, _ := set.Peek(); tx != nil; tx, _ = set.Peek() {
+		txs = append(txs, tx.Tx)
+		set.Shift()
+	}
+	return txs
+}
+
+// Tests that the FIFO policy orders transactions by arrival time, regardless of
+// their price, while honouring the nonce order of senders.
+func TestTransactionFIFOSort(t *testing.T) {
+	t.Parallel()
+
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	groups := map[common.Address][]*txpool.LazyTransaction{
+		crypto.PubkeyToAddress(keyA.PublicKey): makeLazyTxs(t, keyA, []int64{1, 100, 1}, []int64{1, 4, 2}),
+		crypto.PubkeyToAddress(keyB.PublicKey): makeLazyTxs(t, keyB, []int64{50, 50}, []int64{3, 5}),
+	}
+	policy, err := LookupOrderingPolicy(OrderingFIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs := drainSet(policy.NewSet(types.HomesteadSigner{}, groups, nil))
+
+	// The last transaction of A arrived earlier than the second one, but must
+	// still go after it due to the nonce order.
+	want := []struct {
+		key   *ecdsa.PrivateKey
+		nonce uint64
+	}{{keyA, 0}, {keyB, 0}, {keyA, 1}, {keyA, 2}, {keyB, 1}}
+	if len(txs) != len(want) {
+		t.Fatalf("wrong number of transactions: have %d, want %d", len(txs), len(want))
+	}
+	for i, tx := range txs {
+		from, _ := types.Sender(types.HomesteadSigner{}, tx)
+		if from != crypto.PubkeyToAddress(want[i].key.PublicKey) || tx.Nonce() != want[i].nonce {
+			t.Errorf("tx %d: wrong transaction %x nonce %d", i, from[:4], tx.Nonce())
+		}
+	}
+}
+
+// Tests that the round-robin policy includes one transaction of every sender per
+// round, serving the senders by price within a round.
+func TestTransactionRoundRobinSort(t *testing.T) {
+	t.Parallel()
+
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	keyC, _ := crypto.GenerateKey()
+	groups := map[common.Address][]*txpool.LazyTransaction{
+		crypto.PubkeyToAddress(keyA.PublicKey): makeLazyTxs(t, keyA, []int64{100, 100, 100}, []int64{1, 1, 1}),
+		crypto.PubkeyToAddress(keyB.PublicKey): makeLazyTxs(t, keyB, []int64{10, 200}, []int64{1, 1}),
+		crypto.PubkeyToAddress(keyC.PublicKey): makeLazyTxs(t, keyC, []int64{50, 50, 50}, []int64{1, 1, 1}),
+	}
+	policy, err := LookupOrderingPolicy(OrderingRoundRobin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := policy.NewSet(types.HomesteadSigner{}, groups, nil)
+
+	// Drop the remaining transactions of C after its first one.
+	var txs []*types.Transaction
+	for tx, _ := set.Peek(); tx != nil; tx, _ = set.Peek() {
+		txs = append(txs, tx.Tx)
+		if from, _ := types.Sender(types.HomesteadSigner{}, tx.Tx); from == crypto.PubkeyToAddress(keyC.PublicKey) {
+			set.Pop()
+		} else {
+			set.Shift()
+		}
+	}
+	want := []struct {
+		key   *ecdsa.PrivateKey
+		nonce uint64
+	}{{keyA, 0}, {keyC, 0}, {keyB, 0}, {keyB, 1}, {keyA, 1}, {keyA, 2}}
+	if len(txs) != len(want) {
+		t.Fatalf("wrong number of transactions: have %d, want %d", len(txs), len(want))
+	}
+	for i, tx := range txs {
+		from, _ := types.Sender(types.HomesteadSigner{}, tx)
+		if from != crypto.PubkeyToAddress(want[i].key.PublicKey) || tx.Nonce() != want[i].nonce {
+			t.Errorf("tx %d: wrong transaction %x nonce %d", i, from[:4], tx.Nonce())
+		}
+	}
+	if _, err := LookupOrderingPolicy("random"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/bundlepool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -51,6 +52,8 @@ var (
 	testUserKey, _  = crypto.GenerateKey()
 	testUserAddress = crypto.PubkeyToAddress(testUserKey.PublicKey)
 
+	testRevertAddress = common.HexToAddress("0xfd")
+
 	// Test transactions
 	pendingTxs []*types.Transaction
 	newTxs     []*types.Transaction
@@ -99,6 +102,7 @@ func init() {
 type testWorkerBackend struct {
 	db      ethdb.Database
 	txPool  *txpool.TxPool
+	bundles *bundlepool.BundlePool
 	chain   *core.BlockChain
 	genesis *core.Genesis
 }
@@ -106,7 +110,10 @@ type testWorkerBackend struct {
 func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, db ethdb.Database, n int) *testWorkerBackend {
 	var gspec = &core.Genesis{
 		Config: chainConfig,
-		Alloc:  types.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+		Alloc: types.GenesisAlloc{
+			testBankAddress:   {Balance: testBankFunds},
+			testRevertAddress: {Code: []byte{0x60, 0x00, 0x80, 0xfd}}, // PUSH1 0 DUP1 REVERT
+		},
 	}
 	switch e := engine.(type) {
 	case *clique.Clique:
@@ -128,12 +135,14 @@ func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine
 		db:      db,
 		chain:   chain,
 		txPool:  txpool,
+		bundles: bundlepool.New(chain),
 		genesis: gspec,
 	}
 }
 
-func (b *testWorkerBackend) BlockChain() *core.BlockChain { return b.chain }
-func (b *testWorkerBackend) TxPool() *txpool.TxPool       { return b.txPool }
+func (b *testWorkerBackend) BlockChain() *core.BlockChain       { return b.chain }
+func (b *testWorkerBackend) TxPool() *txpool.TxPool             { return b.txPool }
+func (b *testWorkerBackend) BundlePool() *bundlepool.BundlePool { return b.bundles }
 
 func newTestWorker(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, db ethdb.Database, blocks int) (*Miner, *testWorkerBackend) {
 	backend := newTestWorkerBackend(t, chainConfig, engine, db, blocks)
@@ -269,3 +278,42 @@ func TestPayloadId(t *testing.T) {
 		ids[id] = i
 	}
 }
+
+// Tests that conditional transactions are only included in blocks satisfying
+// their condition.
+func TestConditionalTransactions(t *testing.T) {
+	w, b := newTestWorker(t, params.TestChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+
+	minBlock := uint64(2)
+	if err := b.txPool.AddConditional(newTxs[0], &txpool.TxConditional{BlockNumberMin: &minBlock}); err != nil {
+		t.Fatalf("failed to add conditional transaction: %v", err)
+	}
+	r := w.generateWork(&generateParams{
+		timestamp:  uint64(time.Now().Unix()),
+		parentHash: b.chain.CurrentBlock().Hash(),
+		coinbase:   testBankAddress,
+	}, false)
+	if r.err != nil {
+		t.Fatalf("failed to generate work: %v", r.err)
+	}
+	if txs := r.block.Transactions(); len(txs) != len(pendingTxs) {
+		t.Fatalf("transaction count mismatch: have %d, want %d", len(txs), len(pendingTxs))
+	}
+	// Once the condition holds, the transaction is included
+	if _, err := b.chain.InsertChain(types.Blocks{r.block}); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	b.txPool.Sync()
+
+	r = w.generateWork(&generateParams{
+		timestamp:  uint64(time.Now().Unix()) + 1,
+		parentHash: b.chain.CurrentBlock().Hash(),
+		coinbase:   testBankAddress,
+	}, false)
+	if r.err != nil {
+		t.Fatalf("failed to generate work: %v", r.err)
+	}
+	if txs := r.block.Transactions(); len(txs) != 1 || txs[0].Hash() != newTxs[0].Hash() {
+		t.Fatalf("conditional transaction not included")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -352,7 +353,7 @@ func (miner *Miner) applyTransaction(env *environment, tx *types.Transaction) (*
 	return receipt, err
 }
 
-func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *transactionsByPriceAndNonce, interrupt *atomic.Int32) error {
+func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs TransactionSet, interrupt *atomic.Int32) error {
 	var (
 		isCancun = miner.chainConfig.IsCancun(env.header.Number, env.header.Time)
 		gasLimit = env.header.GasLimit
@@ -382,7 +383,7 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 		// Retrieve the next transaction and abort if all done.
 		var (
 			ltx *txpool.LazyTransaction
-			txs *transactionsByPriceAndNonce
+			txs TransactionSet
 		)
 		pltx, ptip := plainTxs.Peek()
 		bltx, btip := blobTxs.Peek()
@@ -445,6 +446,18 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 			txs.Pop()
 			continue
 		}
+		// Check the condition of conditional transactions on top of the block built
+		// so far, the storage roots are only updated on demand.
+		if cond := miner.txpool.Conditional(ltx.Hash); cond != nil {
+			if cond.HasStorageRoots() {
+				env.state.IntermediateRoot(miner.chainConfig.IsEIP158(env.header.Number))
+			}
+			if err := cond.Check(env.header, env.state); err != nil {
+				log.Trace("Skipping conditional transaction", "hash", ltx.Hash, "err", err)
+				txs.Pop()
+				continue
+			}
+		}
 		// Start executing the transaction
 		env.state.SetTxContext(tx.Hash(), env.tcount)
 
@@ -470,14 +483,20 @@ func (miner *Miner) commitTransactions(env *environment, plainTxs, blobTxs *tran
 }
 
 // fillTransactions retrieves the pending transactions from the txpool and fills them
-// into the given sealing block. The transaction selection and ordering strategy can
-// be customized with the plugin in the future.
+// into the given sealing block. Bundles targeting the block go first, followed by
+// the transactions of prioritized senders. The order of the pool transactions is
+// decided by the configured ordering policy.
 func (miner *Miner) fillTransactions(interrupt *atomic.Int32, env *environment) error {
 	miner.confMu.RLock()
 	tip := miner.config.GasPrice
-	prio := miner.prio
+	prio := slices.Concat(miner.prio, miner.config.PriorityAddresses)
+	ordering := miner.ordering
 	miner.confMu.RUnlock()
 
+	// Include the bundles ahead of any pool transactions
+	if err := miner.commitBundles(env, interrupt); err != nil {
+		return err
+	}
 	// Retrieve the pending transactions pre-filtered by the 1559/4844 dynamic fees
 	filter := txpool.PendingFilter{
 		MinTip: uint256.MustFromBig(tip),
@@ -518,16 +537,16 @@ func (miner *Miner) fillTransactions(interrupt *atomic.Int32, env *environment)
 	}
 	// Fill the block with all available pending transactions.
 	if len(prioPlainTxs) > 0 || len(prioBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, prioPlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, prioBlobTxs, env.header.BaseFee)
+		plainTxs := ordering.NewSet(env.signer, prioPlainTxs, env.header.BaseFee)
+		blobTxs := ordering.NewSet(env.signer, prioBlobTxs, env.header.BaseFee)
 
 		if err := miner.commitTransactions(env, plainTxs, blobTxs, interrupt); err != nil {
 			return err
 		}
 	}
 	if len(normalPlainTxs) > 0 || len(normalBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, normalPlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, normalBlobTxs, env.header.BaseFee)
+		plainTxs := ordering.NewSet(env.signer, normalPlainTxs, env.header.BaseFee)
+		blobTxs := ordering.NewSet(env.signer, normalBlobTxs, env.header.BaseFee)
 
 		if err := miner.commitTransactions(env, plainTxs, blobTxs, interrupt); err != nil {
 			return err
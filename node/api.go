@@ -181,6 +181,9 @@ func (api *adminAPI) StartHTTP(host *string, port *int, cors *string, apis *stri
 		rpcEndpointConfig: rpcEndpointConfig{
 			batchItemLimit:         api.node.config.BatchRequestLimit,
 			batchResponseSizeLimit: api.node.config.BatchResponseMaxSize,
+			rateLimiter:            api.node.rateLimiter,
+			accessControl:          api.node.httpAccess,
+			recorder:               api.node.recorder,
 		},
 	}
 	if cors != nil {
@@ -259,6 +262,9 @@ func (api *adminAPI) StartWS(host *string, port *int, allowedOrigins *string, ap
 		rpcEndpointConfig: rpcEndpointConfig{
 			batchItemLimit:         api.node.config.BatchRequestLimit,
 			batchResponseSizeLimit: api.node.config.BatchResponseMaxSize,
+			rateLimiter:            api.node.rateLimiter,
+			accessControl:          api.node.wsAccess,
+			recorder:               api.node.recorder,
 		},
 	}
 	if apis != nil {
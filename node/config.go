@@ -190,6 +190,12 @@ type Config struct {
 	// Requests using ip address directly are not affected
 	GraphQLVirtualHosts []string `toml:",omitempty"`
 
+	// GraphQLTracing enables the transaction tracing fields of the GraphQL API.
+	// Only native tracers can be used and every trace is bounded by a timeout,
+	// but tracing re-executes blocks, so it should only be enabled on endpoints
+	// which are not exposed to untrusted users.
+	GraphQLTracing bool `toml:",omitempty"`
+
 	// Logger is a custom logger to use with the p2p.Server.
 	Logger log.Logger `toml:",omitempty"`
 
@@ -204,6 +210,39 @@ type Config struct {
 	// BatchResponseMaxSize is the maximum number of bytes returned from a batched rpc call.
 	BatchResponseMaxSize int `toml:",omitempty"`
 
+	// RPCRateLimits configures per-client request quotas for groups of methods
+	// served over the public HTTP and WebSocket endpoints.
+	RPCRateLimits []rpc.RateLimitGroup `toml:",omitempty"`
+
+	// HTTPAccessRules, WSAccessRules and AuthAccessRules restrict the methods served
+	// over the respective endpoint. The auth rules apply to both the HTTP and
+	// WebSocket transports of the authenticated endpoint.
+	//
+	// Only the auth rules may be bound to client subjects, as the other endpoints
+	// don't authenticate their clients. Note that subjects are taken from the 'sub'
+	// claim of the JWT token, which any holder of the shared JWT secret can set to
+	// any value. They are not a trust boundary, but a way to apply different rules
+	// to cooperating clients.
+	HTTPAccessRules []rpc.AccessRule `toml:",omitempty"`
+	WSAccessRules   []rpc.AccessRule `toml:",omitempty"`
+	AuthAccessRules []rpc.AccessRule `toml:",omitempty"`
+
+	// HTTPTLS, WSTLS and AuthTLS enable TLS on the respective listeners. If the
+	// WebSocket endpoint shares the port of the HTTP endpoint, the HTTP settings
+	// apply to both. The auth settings apply to the HTTP and WebSocket transports
+	// of the authenticated endpoint.
+	HTTPTLS *TLSConfig `toml:",omitempty"`
+	WSTLS   *TLSConfig `toml:",omitempty"`
+	AuthTLS *TLSConfig `toml:",omitempty"`
+
+	// RPCRecordFile is the file recording the method calls served over the public
+	// HTTP and WebSocket endpoints, along with the responses. Recording is disabled
+	// if empty. The file is rotated once it reaches RPCRecordMaxSize megabytes,
+	// keeping at most RPCRecordMaxBackups rotated files.
+	RPCRecordFile       string `toml:",omitempty"`
+	RPCRecordMaxSize    int    `toml:",omitempty"`
+	RPCRecordMaxBackups int    `toml:",omitempty"`
+
 	// JWTSecret is the path to the hex-encoded jwt secret.
 	JWTSecret string `toml:",omitempty"`
 
@@ -40,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gofrs/flock"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Node is a container on which services can be registered.
@@ -66,6 +67,13 @@ type Node struct {
 	ipc           *ipcServer  // Stores information about the ipc http server
 	inprocHandler *rpc.Server // In-process RPC request handler to process the API requests
 
+	rateLimiter *rpc.RateLimiter   // Client rate limiter shared by the public HTTP and WebSocket endpoints
+	httpAccess  *rpc.AccessControl // Method access rules of the HTTP endpoint
+	wsAccess    *rpc.AccessControl // Method access rules of the WebSocket endpoint
+	authAccess  *rpc.AccessControl // Method access rules of the authenticated endpoint
+	recorder    *rpc.Recorder      // Recorder of the calls served over the public HTTP and WebSocket endpoints
+	recordFile  *lumberjack.Logger // Rotating output of the recorder
+
 	databases map[*closeTrackingDB]struct{} // All open databases
 }
 
@@ -103,11 +111,62 @@ func New(conf *Config) (*Node, error) {
 	if strings.HasSuffix(conf.Name, ".ipc") {
 		return nil, errors.New(`Config.Name cannot end in ".ipc"`)
 	}
+	// The rate limiter is shared by the public HTTP and WebSocket endpoints, so
+	// clients can't bypass their quota by switching transports.
+	var limiter *rpc.RateLimiter
+	if len(conf.RPCRateLimits) > 0 {
+		var err error
+		if limiter, err = rpc.NewRateLimiter(conf.RPCRateLimits); err != nil {
+			return nil, err
+		}
+	}
+	httpAccess, err := newAccessControl(conf.HTTPAccessRules, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP access rules: %v", err)
+	}
+	wsAccess, err := newAccessControl(conf.WSAccessRules, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid WebSocket access rules: %v", err)
+	}
+	authAccess, err := newAccessControl(conf.AuthAccessRules, true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth access rules: %v", err)
+	}
+	httpTLS, err := newTLSConfig(conf.HTTPTLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP TLS config: %v", err)
+	}
+	wsTLS, err := newTLSConfig(conf.WSTLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid WebSocket TLS config: %v", err)
+	}
+	authTLS, err := newTLSConfig(conf.AuthTLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth TLS config: %v", err)
+	}
+	var (
+		recorder   *rpc.Recorder
+		recordFile *lumberjack.Logger
+	)
+	if conf.RPCRecordFile != "" {
+		recordFile = &lumberjack.Logger{
+			Filename:   conf.RPCRecordFile,
+			MaxSize:    conf.RPCRecordMaxSize,
+			MaxBackups: conf.RPCRecordMaxBackups,
+		}
+		recorder = rpc.NewRecorder(recordFile)
+	}
 	server := rpc.NewServer()
 	server.SetBatchLimits(conf.BatchRequestLimit, conf.BatchResponseMaxSize)
 	node := &Node{
 		config:        conf,
 		inprocHandler: server,
+		rateLimiter:   limiter,
+		httpAccess:    httpAccess,
+		wsAccess:      wsAccess,
+		authAccess:    authAccess,
+		recorder:      recorder,
+		recordFile:    recordFile,
 		eventmux:      new(event.TypeMux),
 		log:           conf.Logger,
 		stop:          make(chan struct{}),
@@ -154,6 +213,8 @@ func New(conf *Config) (*Node, error) {
 	node.httpAuth = newHTTPServer(node.log, conf.HTTPTimeouts)
 	node.ws = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts)
 	node.wsAuth = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts)
+	node.http.tlsConfig, node.ws.tlsConfig = httpTLS, wsTLS
+	node.httpAuth.tlsConfig, node.wsAuth.tlsConfig = authTLS, authTLS
 	node.ipc = newIPCServer(node.log, conf.IPCEndpoint())
 
 	return node, nil
@@ -246,6 +307,11 @@ func (n *Node) doClose(errs []error) error {
 			errs = append(errs, err)
 		}
 	}
+	if n.recordFile != nil {
+		if err := n.recordFile.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 
 	// Release instance directory lock.
 	n.closeDataDir()
@@ -393,18 +459,22 @@ func (n *Node) startRPC() error {
 	rpcConfig := rpcEndpointConfig{
 		batchItemLimit:         n.config.BatchRequestLimit,
 		batchResponseSizeLimit: n.config.BatchResponseMaxSize,
+		rateLimiter:            n.rateLimiter,
+		recorder:               n.recorder,
 	}
 
 	initHttp := func(server *httpServer, port int) error {
 		if err := server.setListenAddr(n.config.HTTPHost, port); err != nil {
 			return err
 		}
+		config := rpcConfig
+		config.accessControl = n.httpAccess
 		if err := server.enableRPC(openAPIs, httpConfig{
 			CorsAllowedOrigins: n.config.HTTPCors,
 			Vhosts:             n.config.HTTPVirtualHosts,
 			Modules:            n.config.HTTPModules,
 			prefix:             n.config.HTTPPathPrefix,
-			rpcEndpointConfig:  rpcConfig,
+			rpcEndpointConfig:  config,
 		}); err != nil {
 			return err
 		}
@@ -417,11 +487,13 @@ func (n *Node) startRPC() error {
 		if err := server.setListenAddr(n.config.WSHost, port); err != nil {
 			return err
 		}
+		config := rpcConfig
+		config.accessControl = n.wsAccess
 		if err := server.enableWS(openAPIs, wsConfig{
 			Modules:           n.config.WSModules,
 			Origins:           n.config.WSOrigins,
 			prefix:            n.config.WSPathPrefix,
-			rpcEndpointConfig: rpcConfig,
+			rpcEndpointConfig: config,
 		}); err != nil {
 			return err
 		}
@@ -440,6 +512,7 @@ func (n *Node) startRPC() error {
 			batchItemLimit:         engineAPIBatchItemLimit,
 			batchResponseSizeLimit: engineAPIBatchResponseSizeLimit,
 			httpBodyLimit:          engineAPIBodyLimit,
+			accessControl:          n.authAccess,
 		}
 		err := server.enableRPC(allAPIs, httpConfig{
 			CorsAllowedOrigins: DefaultAuthCors,
@@ -503,6 +576,25 @@ func (n *Node) startRPC() error {
 	return nil
 }
 
+// newAccessControl creates the access control of an RPC endpoint, or nil if the
+// endpoint has no access rules.
+// newAccessControl creates the access control of an endpoint. Rules bound to
+// subjects are rejected for endpoints which don't authenticate their clients, as
+// they would never apply.
+func newAccessControl(rules []rpc.AccessRule, authenticated bool) (*rpc.AccessControl, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	if !authenticated {
+		for i, rule := range rules {
+			if len(rule.Subjects) > 0 {
+				return nil, fmt.Errorf("access rule %d has subjects, but the endpoint does not authenticate clients", i)
+			}
+		}
+	}
+	return rpc.NewAccessControl(rules)
+}
+
 func (n *Node) wsServerForPort(port int, authenticated bool) *httpServer {
 	httpServer, wsServer := n.http, n.ws
 	if authenticated {
@@ -606,6 +698,17 @@ func (n *Node) RegisterHandler(name, path string, handler http.Handler) {
 	n.http.handlerNames[path] = name
 }
 
+// RegisterHTTP2Handler mounts a handler on the given path on the canonical HTTP
+// server like RegisterHandler, additionally enabling unencrypted HTTP/2 (h2c) on
+// the server for clients with prior knowledge.
+func (n *Node) RegisterHTTP2Handler(name, path string, handler http.Handler) {
+	n.RegisterHandler(name, path, handler)
+
+	n.lock.Lock()
+	n.http.h2c = true
+	n.lock.Unlock()
+}
+
 // Attach creates an RPC client attached to an in-process API handler.
 func (n *Node) Attach() *rpc.Client {
 	return rpc.DialInProc(n.inprocHandler)
@@ -622,6 +725,22 @@ func (n *Node) RPCHandler() (*rpc.Server, error) {
 	return n.inprocHandler, nil
 }
 
+// HTTPRPCServer returns the JSON-RPC server of the HTTP endpoint, or nil if JSON-RPC
+// over HTTP is not enabled. Calls served by it are subject to the modules, access
+// rules, rate limits and recording configured for the endpoint.
+func (n *Node) HTTPRPCServer() *rpc.Server {
+	if handler := n.http.httpHandler.Load(); handler != nil {
+		return handler.server
+	}
+	return nil
+}
+
+// RPCRecorder returns the recorder of the calls served over the public HTTP and
+// WebSocket endpoints, or nil if recording is disabled.
+func (n *Node) RPCRecorder() *rpc.Recorder {
+	return n.recorder
+}
+
 // Config returns the configuration of node.
 func (n *Node) Config() *Config {
 	return n.config
@@ -666,28 +785,28 @@ func (n *Node) IPCEndpoint() string {
 // HTTPEndpoint returns the URL of the HTTP server. Note that this URL does not
 // contain the JSON-RPC path prefix set by HTTPPathPrefix.
 func (n *Node) HTTPEndpoint() string {
-	return "http://" + n.http.listenAddr()
+	return n.http.scheme("http") + "://" + n.http.listenAddr()
 }
 
 // WSEndpoint returns the current JSON-RPC over WebSocket endpoint.
 func (n *Node) WSEndpoint() string {
 	if n.http.wsAllowed() {
-		return "ws://" + n.http.listenAddr() + n.http.wsConfig.prefix
+		return n.http.scheme("ws") + "://" + n.http.listenAddr() + n.http.wsConfig.prefix
 	}
-	return "ws://" + n.ws.listenAddr() + n.ws.wsConfig.prefix
+	return n.ws.scheme("ws") + "://" + n.ws.listenAddr() + n.ws.wsConfig.prefix
 }
 
 // HTTPAuthEndpoint returns the URL of the authenticated HTTP server.
 func (n *Node) HTTPAuthEndpoint() string {
-	return "http://" + n.httpAuth.listenAddr()
+	return n.httpAuth.scheme("http") + "://" + n.httpAuth.listenAddr()
 }
 
 // WSAuthEndpoint returns the current authenticated JSON-RPC over WebSocket endpoint.
 func (n *Node) WSAuthEndpoint() string {
 	if n.httpAuth.wsAllowed() {
-		return "ws://" + n.httpAuth.listenAddr() + n.httpAuth.wsConfig.prefix
+		return n.httpAuth.scheme("ws") + "://" + n.httpAuth.listenAddr() + n.httpAuth.wsConfig.prefix
 	}
-	return "ws://" + n.wsAuth.listenAddr() + n.wsAuth.wsConfig.prefix
+	return n.wsAuth.scheme("ws") + "://" + n.wsAuth.listenAddr() + n.wsAuth.wsConfig.prefix
 }
 
 // EventMux retrieves the event multiplexer used by all the network services in
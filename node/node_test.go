@@ -416,7 +416,74 @@ func TestRegisterHandler_Successful(t *testing.T) {
 	assert.Equal(t, "success", string(buf))
 }
 
+// Tests that unencrypted HTTP/2 is only served if requested by a handler.
+func TestRegisterHTTP2Handler(t *testing.T) {
+	h2c := new(http.Transport)
+	h2c.Protocols = new(http.Protocols)
+	h2c.Protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: h2c}
+	defer client.CloseIdleConnections()
+
+	for _, http2 := range []bool{false, true} {
+		node, err := New(&Config{HTTPHost: "127.0.0.1", HTTPPort: 0})
+		if err != nil {
+			t.Fatalf("could not create node: %v", err)
+		}
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(r.Proto))
+		})
+		if http2 {
+			node.RegisterHTTP2Handler("test", "/test", handler)
+		} else {
+			node.RegisterHandler("test", "/test", handler)
+		}
+		if err := node.Start(); err != nil {
+			t.Fatalf("could not start node: %v", err)
+		}
+		resp, err := client.Get(node.HTTPEndpoint() + "/test")
+		if !http2 {
+			if err == nil {
+				resp.Body.Close()
+				t.Error("unencrypted HTTP/2 served without HTTP/2 handler")
+			}
+		} else if err != nil {
+			t.Errorf("unencrypted HTTP/2 request failed: %v", err)
+		} else {
+			proto, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if string(proto) != "HTTP/2.0" {
+				t.Errorf("wrong protocol: %s", proto)
+			}
+		}
+		node.Close()
+	}
+}
+
 // Tests whether websocket requests can be handled on the same port as a regular http server.
+// Tests that access rules bound to subjects are only accepted for the endpoint
+// authenticating its clients.
+func TestAccessRuleSubjects(t *testing.T) {
+	rules := []rpc.AccessRule{{Subjects: []string{"admin"}, Allow: []string{"*"}}}
+
+	conf := testNodeConfig()
+	conf.HTTPAccessRules = rules
+	if _, err := New(conf); err == nil {
+		t.Error("subject-bound HTTP access rules accepted")
+	}
+	conf = testNodeConfig()
+	conf.WSAccessRules = rules
+	if _, err := New(conf); err == nil {
+		t.Error("subject-bound WebSocket access rules accepted")
+	}
+	conf = testNodeConfig()
+	conf.AuthAccessRules = rules
+	stack, err := New(conf)
+	if err != nil {
+		t.Fatalf("subject-bound auth access rules rejected: %v", err)
+	}
+	stack.Close()
+}
+
 func TestWebsocketHTTPOnSamePort_WebsocketRequest(t *testing.T) {
 	node := startHTTP(t, 0, 0)
 	defer node.Close()
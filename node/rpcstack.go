@@ -19,9 +19,11 @@ package node
 import (
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"sort"
@@ -58,6 +60,9 @@ type rpcEndpointConfig struct {
 	batchItemLimit         int
 	batchResponseSizeLimit int
 	httpBodyLimit          int
+	rateLimiter            *rpc.RateLimiter   // optional client rate limiter
+	accessControl          *rpc.AccessControl // optional method access rules
+	recorder               *rpc.Recorder      // optional recorder of the served calls
 }
 
 type rpcHandler struct {
@@ -75,6 +80,8 @@ type httpServer struct {
 	server   *http.Server
 	listener net.Listener // non-nil when server is running
 
+	tlsConfig *tls.Config // serves plain HTTP if nil
+
 	// HTTP RPC handler things.
 
 	httpConfig  httpConfig
@@ -90,6 +97,7 @@ type httpServer struct {
 	port     int
 
 	handlerNames map[string]string
+	h2c          bool // serve unencrypted HTTP/2 with prior knowledge
 }
 
 const (
@@ -127,6 +135,15 @@ func (h *httpServer) listenAddr() string {
 	return h.endpoint
 }
 
+// scheme returns the URL scheme of the server for the given plain scheme, i.e.
+// "https" instead of "http" if the server has TLS enabled.
+func (h *httpServer) scheme(plain string) string {
+	if h.tlsConfig != nil {
+		return plain + "s"
+	}
+	return plain
+}
+
 // start starts the HTTP server if it is enabled and not already running.
 func (h *httpServer) start() error {
 	h.mu.Lock()
@@ -136,8 +153,15 @@ func (h *httpServer) start() error {
 		return nil // already running or not configured
 	}
 
-	// Initialize the server.
+	// Initialize the server. If requested by a handler, HTTP/2 is served with
+	// prior knowledge of the client over plain connections as well.
 	h.server = &http.Server{Handler: h}
+	if h.h2c {
+		h.server.Protocols = new(http.Protocols)
+		h.server.Protocols.SetHTTP1(true)
+		h.server.Protocols.SetHTTP2(true)
+		h.server.Protocols.SetUnencryptedHTTP2(true)
+	}
 	if h.timeouts != (rpc.HTTPTimeouts{}) {
 		CheckTimeouts(&h.timeouts)
 		h.server.ReadTimeout = h.timeouts.ReadTimeout
@@ -155,11 +179,17 @@ func (h *httpServer) start() error {
 		h.disableWS()
 		return err
 	}
+	if h.tlsConfig != nil {
+		// Failed handshakes are common on public listeners, don't let them
+		// reach the standard logger.
+		h.server.ErrorLog = slog.NewLogLogger(h.log.Handler(), log.LevelDebug)
+		listener = tls.NewListener(listener, h.tlsConfig)
+	}
 	h.listener = listener
 	go h.server.Serve(listener)
 
 	if h.wsAllowed() {
-		url := fmt.Sprintf("ws://%v", listener.Addr())
+		url := fmt.Sprintf("%s://%v", h.scheme("ws"), listener.Addr())
 		if h.wsConfig.prefix != "" {
 			url += h.wsConfig.prefix
 		}
@@ -171,7 +201,7 @@ func (h *httpServer) start() error {
 	}
 	// Log http endpoint.
 	h.log.Info("HTTP server started",
-		"endpoint", listener.Addr(), "auth", h.httpConfig.jwtSecret != nil,
+		"endpoint", listener.Addr(), "auth", h.httpConfig.jwtSecret != nil, "tls", h.tlsConfig != nil,
 		"prefix", h.httpConfig.prefix,
 		"cors", strings.Join(h.httpConfig.CorsAllowedOrigins, ","),
 		"vhosts", strings.Join(h.httpConfig.Vhosts, ","),
@@ -187,7 +217,7 @@ func (h *httpServer) start() error {
 	for _, path := range paths {
 		name := h.handlerNames[path]
 		if !logged[name] {
-			log.Info(name+" enabled", "url", "http://"+listener.Addr().String()+path)
+			log.Info(name+" enabled", "url", h.scheme("http")+"://"+listener.Addr().String()+path)
 			logged[name] = true
 		}
 	}
@@ -200,6 +230,14 @@ func (h *httpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if ws != nil && isWebsocket(r) {
 		if checkPath(r, ws.prefix) {
 			ws.ServeHTTP(w, r)
+			return
+		}
+		// Handlers registered via Node.RegisterHandler may serve their
+		// own websocket endpoints (e.g. GraphQL subscriptions).
+		if h.httpHandler.Load() != nil {
+			if muxHandler, pattern := h.mux.Handler(r); pattern != "" {
+				muxHandler.ServeHTTP(w, r)
+			}
 		}
 		return
 	}
@@ -304,6 +342,9 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
 	srv.SetBatchLimits(config.batchItemLimit, config.batchResponseSizeLimit)
+	srv.SetRateLimiter(config.rateLimiter)
+	srv.SetAccessControl(config.accessControl)
+	srv.SetRecorder(config.recorder)
 	if config.httpBodyLimit > 0 {
 		srv.SetHTTPBodyLimit(config.httpBodyLimit)
 	}
@@ -340,6 +381,9 @@ func (h *httpServer) enableWS(apis []rpc.API, config wsConfig) error {
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
 	srv.SetBatchLimits(config.batchItemLimit, config.batchResponseSizeLimit)
+	srv.SetRateLimiter(config.rateLimiter)
+	srv.SetAccessControl(config.accessControl)
+	srv.SetRecorder(config.recorder)
 	if config.httpBodyLimit > 0 {
 		srv.SetHTTPBodyLimit(config.httpBodyLimit)
 	}
@@ -579,6 +579,18 @@ func TestHTTPWriteTimeout(t *testing.T) {
 		}
 	})
 
+	// Streamed result which is produced slower than the timeout
+	t.Run("stream", func(t *testing.T) {
+		resp := rpcRequest(t, url, "test_sleepStream")
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != timeoutRes {
+			t.Errorf("wrong response. have %s, want %s", string(body), timeoutRes)
+		}
+	})
+
 	// Batch request
 	t.Run("batch", func(t *testing.T) {
 		want := fmt.Sprintf("[%s,%s,%s]", greetRes, timeoutRes, timeoutRes)
@@ -611,3 +623,14 @@ func (s *testService) Greet() string {
 func (s *testService) Sleep() {
 	time.Sleep(1500 * time.Millisecond)
 }
+
+func (s *testService) SleepStream() *rpc.ArrayStream[int] {
+	return rpc.NewArrayStream(func(yield func(int, error) bool) {
+		for i := 0; i < 30; i++ {
+			time.Sleep(100 * time.Millisecond)
+			if !yield(i, nil) {
+				return
+			}
+		}
+	})
+}
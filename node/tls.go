@@ -0,0 +1,198 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// tlsReloadInterval is the minimum time between two checks of the certificate
+// files for modifications.
+const tlsReloadInterval = 10 * time.Second
+
+// TLSConfig configures TLS termination of an RPC listener.
+type TLSConfig struct {
+	// CertFile and KeyFile are the paths of the PEM encoded certificate chain and
+	// private key of the server.
+	CertFile string
+	KeyFile  string
+
+	// ClientCAFile is the path of the PEM encoded CA certificates client certificates
+	// are verified against. If set, clients must present a valid certificate.
+	ClientCAFile string `toml:",omitempty"`
+
+	// MinVersion is the minimum TLS version accepted, "1.2" or "1.3". It defaults
+	// to TLS 1.2.
+	MinVersion string `toml:",omitempty"`
+}
+
+// tlsCertificates holds the TLS configuration of a listener, reloading the
+// certificate files when they change on disk.
+type tlsCertificates struct {
+	config     TLSConfig
+	minVersion uint16
+
+	mu        sync.Mutex
+	checked   time.Time   // time of the last modification check
+	modTimes  []time.Time // modification times of the loaded files
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+// newTLSConfig creates the TLS configuration of a listener. The certificate files
+// are loaded immediately and reloaded on a TLS handshake after they changed.
+func newTLSConfig(config *TLSConfig) (*tls.Config, error) {
+	if config == nil {
+		return nil, nil
+	}
+	c, err := newTLSCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:         c.minVersion,
+		GetConfigForClient: c.configForClient,
+	}, nil
+}
+
+// newTLSCertificates loads the certificate files of a TLS configuration.
+func newTLSCertificates(config *TLSConfig) (*tlsCertificates, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errors.New("TLS certificate and key file required")
+	}
+	c := &tlsCertificates{config: *config}
+	switch config.MinVersion {
+	case "", "1.2":
+		c.minVersion = tls.VersionTLS12
+	case "1.3":
+		c.minVersion = tls.VersionTLS13
+	default:
+		return nil, fmt.Errorf("unsupported minimum TLS version %q", config.MinVersion)
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// files returns the paths of the certificate files.
+func (c *tlsCertificates) files() []string {
+	files := []string{c.config.CertFile, c.config.KeyFile}
+	if c.config.ClientCAFile != "" {
+		files = append(files, c.config.ClientCAFile)
+	}
+	return files
+}
+
+// modified returns the current modification times of the certificate files.
+func (c *tlsCertificates) modified() ([]time.Time, error) {
+	var times []time.Time
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		times = append(times, info.ModTime())
+	}
+	return times, nil
+}
+
+// load reads the certificate files.
+func (c *tlsCertificates) load() error {
+	times, err := c.modified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(c.config.CertFile, c.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %v", err)
+	}
+	var pool *x509.CertPool
+	if c.config.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS client CA: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in TLS client CA file %s", c.config.ClientCAFile)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checked, c.modTimes = time.Now(), times
+	c.cert, c.clientCAs = &cert, pool
+	return nil
+}
+
+// reload loads the certificate files again if they were modified since they were
+// last loaded. Failures are logged, the previous certificates are kept in use.
+func (c *tlsCertificates) reload() {
+	c.mu.Lock()
+	if time.Since(c.checked) < tlsReloadInterval {
+		c.mu.Unlock()
+		return
+	}
+	c.checked = time.Now()
+	loaded := c.modTimes
+	c.mu.Unlock()
+
+	times, err := c.modified()
+	if err != nil {
+		log.Warn("Failed to check TLS certificates", "err", err)
+		return
+	}
+	for i := range times {
+		if !times[i].Equal(loaded[i]) {
+			if err := c.load(); err != nil {
+				log.Warn("Failed to reload TLS certificates", "cert", c.config.CertFile, "err", err)
+				return
+			}
+			log.Info("Reloaded TLS certificates", "cert", c.config.CertFile)
+			return
+		}
+	}
+}
+
+// configForClient returns the TLS configuration of a handshake with the current
+// certificates.
+func (c *tlsCertificates) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	c.reload()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config := &tls.Config{
+		MinVersion:   c.minVersion,
+		Certificates: []tls.Certificate{*c.cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if c.clientCAs != nil {
+		config.ClientCAs = c.clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+type peerInfoRPC struct{}
+
+func (peerInfoRPC) PeerInfo(ctx context.Context) rpc.PeerInfo {
+	return rpc.PeerInfoFromContext(ctx)
+}
+
+// testCert is a certificate and key generated for a test.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert creates a certificate signed by the given parent, or a self-signed
+// CA certificate if parent is nil.
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA, template.BasicConstraintsValid = true, true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// write stores the certificate and key as PEM files.
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+
+	keyDer, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if keyFile != "" {
+		if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func TestTLSEndpoints(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		certFile = filepath.Join(dir, "server.crt")
+		keyFile  = filepath.Join(dir, "server.key")
+		caFile   = filepath.Join(dir, "ca.crt")
+
+		ca     = newTestCert(t, "ca", nil)
+		server = newTestCert(t, "server", ca)
+		client = newTestCert(t, "client", ca)
+		rogue  = newTestCert(t, "rogue", nil)
+	)
+	ca.write(t, caFile, "")
+	server.write(t, certFile, keyFile)
+
+	node, err := New(&Config{
+		HTTPHost:    "127.0.0.1",
+		WSHost:      "127.0.0.1",
+		HTTPModules: []string{"test"},
+		WSModules:   []string{"test"},
+		HTTPTLS: &TLSConfig{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			ClientCAFile: caFile,
+			MinVersion:   "1.3",
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	node.RegisterAPIs([]rpc.API{{Namespace: "test", Service: peerInfoRPC{}}})
+	if err := node.Start(); err != nil {
+		t.Fatalf("failed to start node: %v", err)
+	}
+	defer node.Close()
+
+	if !strings.HasPrefix(node.HTTPEndpoint(), "https://") || !strings.HasPrefix(node.WSEndpoint(), "wss://") {
+		t.Fatalf("wrong endpoint schemes: %s, %s", node.HTTPEndpoint(), node.WSEndpoint())
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	dial := func(endpoint string, cert *testCert) (*rpc.Client, error) {
+		config := &tls.Config{RootCAs: roots}
+		if cert != nil {
+			config.Certificates = []tls.Certificate{cert.tlsCertificate()}
+		}
+		return rpc.DialOptions(context.Background(), endpoint,
+			rpc.WithHTTPClient(&http.Client{Transport: &http.Transport{TLSClientConfig: config}}),
+			rpc.WithWebsocketDialer(websocket.Dialer{TLSClientConfig: config}),
+		)
+	}
+	for _, endpoint := range []string{node.HTTPEndpoint(), node.WSEndpoint()} {
+		// Clients with a valid certificate are served, with the subject of the
+		// certificate available to the handlers.
+		c, err := dial(endpoint, client)
+		if err != nil {
+			t.Fatalf("%s: dial failed: %v", endpoint, err)
+		}
+		var info rpc.PeerInfo
+		if err := c.Call(&info, "test_peerInfo"); err != nil {
+			t.Fatalf("%s: call failed: %v", endpoint, err)
+		}
+		c.Close()
+		if info.ClientCertSubject != "CN=client" {
+			t.Errorf("%s: wrong client certificate subject %q", endpoint, info.ClientCertSubject)
+		}
+		// Clients without a certificate or with an unknown one are rejected.
+		for _, cert := range []*testCert{nil, rogue} {
+			c, err := dial(endpoint, cert)
+			if err == nil {
+				err = c.Call(&info, "test_peerInfo")
+				c.Close()
+			}
+			if err == nil {
+				t.Errorf("%s: call without valid client certificate succeeded", endpoint)
+			}
+		}
+	}
+}
+
+func TestTLSReload(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		certFile = filepath.Join(dir, "server.crt")
+		keyFile  = filepath.Join(dir, "server.key")
+		first    = newTestCert(t, "first", nil)
+		second   = newTestCert(t, "second", nil)
+	)
+	first.write(t, certFile, keyFile)
+
+	certs, err := newTLSCertificates(&TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := func() string {
+		t.Helper()
+		config, err := certs.configForClient(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert.Subject.CommonName
+	}
+	if name := served(); name != "first" {
+		t.Fatalf("wrong certificate served: %s", name)
+	}
+	// Replace the certificate, it is picked up on the next check.
+	second.write(t, certFile, keyFile)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+	os.Chtimes(keyFile, future, future)
+	if name := served(); name != "first" {
+		t.Fatalf("certificate reloaded before check interval: %s", name)
+	}
+	certs.mu.Lock()
+	certs.checked = time.Time{}
+	certs.mu.Unlock()
+	if name := served(); name != "second" {
+		t.Fatalf("certificate not reloaded: %s", name)
+	}
+	// Broken files are ignored, the previous certificate remains in use.
+	os.WriteFile(keyFile, []byte("garbage"), 0600)
+	future = future.Add(time.Minute)
+	os.Chtimes(keyFile, future, future)
+	certs.mu.Lock()
+	certs.checked = time.Time{}
+	certs.mu.Unlock()
+	if name := served(); name != "second" {
+		t.Fatalf("wrong certificate after failed reload: %s", name)
+	}
+}
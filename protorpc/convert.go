@@ -0,0 +1,377 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package protorpc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EncodeHeader converts a block header into its protobuf representation.
+func EncodeHeader(h *types.Header) *Header {
+	enc := &Header{
+		ParentHash:    h.ParentHash.Bytes(),
+		UncleHash:     h.UncleHash.Bytes(),
+		Coinbase:      h.Coinbase.Bytes(),
+		Root:          h.Root.Bytes(),
+		TxHash:        h.TxHash.Bytes(),
+		ReceiptHash:   h.ReceiptHash.Bytes(),
+		Bloom:         h.Bloom.Bytes(),
+		Difficulty:    encodeBig(h.Difficulty),
+		Number:        h.Number.Uint64(),
+		GasLimit:      h.GasLimit,
+		GasUsed:       h.GasUsed,
+		Time:          h.Time,
+		Extra:         h.Extra,
+		MixDigest:     h.MixDigest.Bytes(),
+		Nonce:         h.Nonce[:],
+		BaseFee:       encodeBig(h.BaseFee),
+		BlobGasUsed:   h.BlobGasUsed,
+		ExcessBlobGas: h.ExcessBlobGas,
+	}
+	if h.WithdrawalsHash != nil {
+		enc.WithdrawalsHash = h.WithdrawalsHash.Bytes()
+	}
+	if h.ParentBeaconRoot != nil {
+		enc.ParentBeaconRoot = h.ParentBeaconRoot.Bytes()
+	}
+	if h.RequestsHash != nil {
+		enc.RequestsHash = h.RequestsHash.Bytes()
+	}
+	return enc
+}
+
+// DecodeHeader converts a protobuf header back into a block header.
+func DecodeHeader(enc *Header) (*types.Header, error) {
+	if enc == nil {
+		return nil, errors.New("missing header")
+	}
+	d := decoder{}
+	h := &types.Header{
+		ParentHash:    d.hash(enc.ParentHash),
+		UncleHash:     d.hash(enc.UncleHash),
+		Coinbase:      d.address(enc.Coinbase),
+		Root:          d.hash(enc.Root),
+		TxHash:        d.hash(enc.TxHash),
+		ReceiptHash:   d.hash(enc.ReceiptHash),
+		Bloom:         d.bloom(enc.Bloom),
+		Difficulty:    new(big.Int).SetBytes(enc.Difficulty),
+		Number:        new(big.Int).SetUint64(enc.Number),
+		GasLimit:      enc.GasLimit,
+		GasUsed:       enc.GasUsed,
+		Time:          enc.Time,
+		Extra:         enc.Extra,
+		MixDigest:     d.hash(enc.MixDigest),
+		BaseFee:       decodeBig(enc.BaseFee),
+		BlobGasUsed:   enc.BlobGasUsed,
+		ExcessBlobGas: enc.ExcessBlobGas,
+	}
+	if len(enc.Nonce) != len(h.Nonce) {
+		d.fail("nonce", len(enc.Nonce))
+	}
+	copy(h.Nonce[:], enc.Nonce)
+
+	if enc.WithdrawalsHash != nil {
+		hash := d.hash(enc.WithdrawalsHash)
+		h.WithdrawalsHash = &hash
+	}
+	if enc.ParentBeaconRoot != nil {
+		hash := d.hash(enc.ParentBeaconRoot)
+		h.ParentBeaconRoot = &hash
+	}
+	if enc.RequestsHash != nil {
+		hash := d.hash(enc.RequestsHash)
+		h.RequestsHash = &hash
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid header: %w", d.err)
+	}
+	return h, nil
+}
+
+// EncodeBlock converts a block into its protobuf representation. If fullTx is
+// false, the transactions are only referenced by hash.
+func EncodeBlock(b *types.Block, fullTx bool) (*Block, error) {
+	enc := &Block{
+		Header:         EncodeHeader(b.Header()),
+		HasWithdrawals: b.Withdrawals() != nil,
+	}
+	for _, tx := range b.Transactions() {
+		if !fullTx {
+			enc.TransactionHashes = append(enc.TransactionHashes, tx.Hash().Bytes())
+			continue
+		}
+		blob, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		enc.Transactions = append(enc.Transactions, blob)
+	}
+	for _, uncle := range b.Uncles() {
+		enc.Uncles = append(enc.Uncles, EncodeHeader(uncle))
+	}
+	enc.Withdrawals = encodeWithdrawals(b.Withdrawals())
+	return enc, nil
+}
+
+// encodeWithdrawals converts a list of withdrawals into its protobuf representation.
+func encodeWithdrawals(ws types.Withdrawals) []*Withdrawal {
+	var enc []*Withdrawal
+	for _, w := range ws {
+		enc = append(enc, &Withdrawal{
+			Index:     w.Index,
+			Validator: w.Validator,
+			Address:   w.Address.Bytes(),
+			Amount:    w.Amount,
+		})
+	}
+	return enc
+}
+
+// DecodeBlock converts a protobuf block back into a block. Blocks referencing
+// their transactions by hash only can't be decoded.
+func DecodeBlock(enc *Block) (*types.Block, error) {
+	if enc == nil {
+		return nil, errors.New("missing block")
+	}
+	if len(enc.TransactionHashes) > 0 {
+		return nil, errors.New("block without transaction bodies")
+	}
+	header, err := DecodeHeader(enc.Header)
+	if err != nil {
+		return nil, err
+	}
+	var body types.Body
+	for i, blob := range enc.Transactions {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(blob); err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+		body.Transactions = append(body.Transactions, tx)
+	}
+	for _, uncle := range enc.Uncles {
+		h, err := DecodeHeader(uncle)
+		if err != nil {
+			return nil, err
+		}
+		body.Uncles = append(body.Uncles, h)
+	}
+	if enc.HasWithdrawals {
+		body.Withdrawals = make(types.Withdrawals, 0, len(enc.Withdrawals))
+	}
+	d := decoder{}
+	for _, w := range enc.Withdrawals {
+		body.Withdrawals = append(body.Withdrawals, &types.Withdrawal{
+			Index:     w.Index,
+			Validator: w.Validator,
+			Address:   d.address(w.Address),
+			Amount:    w.Amount,
+		})
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid withdrawal: %w", d.err)
+	}
+	return types.NewBlockWithHeader(header).WithBody(body), nil
+}
+
+// EncodeLog converts a log into its protobuf representation.
+func EncodeLog(l *types.Log) *Log {
+	enc := &Log{
+		Address:        l.Address.Bytes(),
+		Topics:         make([][]byte, len(l.Topics)),
+		Data:           l.Data,
+		BlockNumber:    l.BlockNumber,
+		TxHash:         l.TxHash.Bytes(),
+		TxIndex:        uint32(l.TxIndex),
+		BlockHash:      l.BlockHash.Bytes(),
+		BlockTimestamp: l.BlockTimestamp,
+		Index:          uint32(l.Index),
+		Removed:        l.Removed,
+	}
+	for i, topic := range l.Topics {
+		enc.Topics[i] = topic.Bytes()
+	}
+	return enc
+}
+
+// DecodeLog converts a protobuf log back into a log.
+func DecodeLog(enc *Log) (*types.Log, error) {
+	d := decoder{}
+	l := &types.Log{
+		Address:        d.address(enc.Address),
+		Topics:         make([]common.Hash, len(enc.Topics)),
+		Data:           enc.Data,
+		BlockNumber:    enc.BlockNumber,
+		TxHash:         d.hash(enc.TxHash),
+		TxIndex:        uint(enc.TxIndex),
+		BlockHash:      d.hash(enc.BlockHash),
+		BlockTimestamp: enc.BlockTimestamp,
+		Index:          uint(enc.Index),
+		Removed:        enc.Removed,
+	}
+	if l.Data == nil {
+		l.Data = []byte{}
+	}
+	for i, topic := range enc.Topics {
+		l.Topics[i] = d.hash(topic)
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid log: %w", d.err)
+	}
+	return l, nil
+}
+
+// EncodeLogs converts a list of logs into its protobuf representation.
+func EncodeLogs(logs []*types.Log, cursor string) *Logs {
+	enc := &Logs{
+		Logs:   make([]*Log, len(logs)),
+		Cursor: cursor,
+	}
+	for i, l := range logs {
+		enc.Logs[i] = EncodeLog(l)
+	}
+	return enc
+}
+
+// DecodeLogs converts a protobuf list of logs back into logs.
+func DecodeLogs(enc *Logs) ([]*types.Log, error) {
+	logs := make([]*types.Log, len(enc.GetLogs()))
+	for i, l := range enc.GetLogs() {
+		dec, err := DecodeLog(l)
+		if err != nil {
+			return nil, err
+		}
+		logs[i] = dec
+	}
+	return logs, nil
+}
+
+// EncodeReceipt converts a receipt into its protobuf representation.
+func EncodeReceipt(r *types.Receipt) *Receipt {
+	enc := &Receipt{
+		Type:              uint32(r.Type),
+		PostState:         r.PostState,
+		Status:            r.Status,
+		CumulativeGasUsed: r.CumulativeGasUsed,
+		Bloom:             r.Bloom.Bytes(),
+		Logs:              make([]*Log, len(r.Logs)),
+		TxHash:            r.TxHash.Bytes(),
+		ContractAddress:   r.ContractAddress.Bytes(),
+		GasUsed:           r.GasUsed,
+		EffectiveGasPrice: encodeBig(r.EffectiveGasPrice),
+		BlobGasUsed:       r.BlobGasUsed,
+		BlobGasPrice:      encodeBig(r.BlobGasPrice),
+		BlockHash:         r.BlockHash.Bytes(),
+		TransactionIndex:  uint32(r.TransactionIndex),
+	}
+	if r.BlockNumber != nil {
+		enc.BlockNumber = r.BlockNumber.Uint64()
+	}
+	for i, l := range r.Logs {
+		enc.Logs[i] = EncodeLog(l)
+	}
+	return enc
+}
+
+// DecodeReceipt converts a protobuf receipt back into a receipt.
+func DecodeReceipt(enc *Receipt) (*types.Receipt, error) {
+	if enc == nil {
+		return nil, errors.New("missing receipt")
+	}
+	d := decoder{}
+	r := &types.Receipt{
+		Type:              uint8(enc.Type),
+		PostState:         enc.PostState,
+		Status:            enc.Status,
+		CumulativeGasUsed: enc.CumulativeGasUsed,
+		Bloom:             d.bloom(enc.Bloom),
+		Logs:              make([]*types.Log, len(enc.Logs)),
+		TxHash:            d.hash(enc.TxHash),
+		ContractAddress:   d.address(enc.ContractAddress),
+		GasUsed:           enc.GasUsed,
+		EffectiveGasPrice: decodeBig(enc.EffectiveGasPrice),
+		BlobGasUsed:       enc.BlobGasUsed,
+		BlobGasPrice:      decodeBig(enc.BlobGasPrice),
+		BlockHash:         d.hash(enc.BlockHash),
+		BlockNumber:       new(big.Int).SetUint64(enc.BlockNumber),
+		TransactionIndex:  uint(enc.TransactionIndex),
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid receipt: %w", d.err)
+	}
+	for i, l := range enc.Logs {
+		dec, err := DecodeLog(l)
+		if err != nil {
+			return nil, err
+		}
+		r.Logs[i] = dec
+	}
+	return r, nil
+}
+
+// encodeBig encodes an optional big integer, keeping zero distinguishable from
+// an absent value.
+func encodeBig(x *big.Int) []byte {
+	if x == nil {
+		return nil
+	}
+	return append([]byte{}, x.Bytes()...)
+}
+
+// decodeBig decodes an optional big integer.
+func decodeBig(enc []byte) *big.Int {
+	if enc == nil {
+		return nil
+	}
+	return new(big.Int).SetBytes(enc)
+}
+
+// decoder converts fixed size fields, recording the first length mismatch.
+type decoder struct {
+	err error
+}
+
+func (d *decoder) fail(field string, length int) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%s has invalid length %d", field, length)
+	}
+}
+
+func (d *decoder) hash(enc []byte) common.Hash {
+	if len(enc) != common.HashLength {
+		d.fail("hash", len(enc))
+	}
+	return common.BytesToHash(enc)
+}
+
+func (d *decoder) address(enc []byte) common.Address {
+	if len(enc) != common.AddressLength {
+		d.fail("address", len(enc))
+	}
+	return common.BytesToAddress(enc)
+}
+
+func (d *decoder) bloom(enc []byte) types.Bloom {
+	if len(enc) != types.BloomByteLength {
+		d.fail("bloom", len(enc))
+	}
+	return types.BytesToBloom(enc)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package protorpc
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBlockRoundTrip(t *testing.T) {
+	var (
+		blobGas   = uint64(131072)
+		excessGas = uint64(0)
+		hash      = common.Hash{0x01}
+		header    = &types.Header{
+			ParentHash:       common.Hash{0x02},
+			Coinbase:         common.Address{0x03},
+			Difficulty:       new(big.Int),
+			Number:           big.NewInt(100),
+			GasLimit:         30_000_000,
+			GasUsed:          21000,
+			Time:             1700000000,
+			Extra:            []byte("extra"),
+			Nonce:            types.EncodeNonce(7),
+			BaseFee:          big.NewInt(0),
+			WithdrawalsHash:  &hash,
+			BlobGasUsed:      &blobGas,
+			ExcessBlobGas:    &excessGas,
+			ParentBeaconRoot: &hash,
+		}
+		tx = types.NewTx(&types.DynamicFeeTx{
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21000,
+			To:        &common.Address{0x04},
+			Value:     big.NewInt(5),
+		})
+		withdrawals = types.Withdrawals{{Index: 1, Validator: 2, Address: common.Address{0x05}, Amount: 3}}
+		block       = types.NewBlockWithHeader(header).WithBody(types.Body{
+			Transactions: types.Transactions{tx},
+			Withdrawals:  withdrawals,
+		})
+	)
+	enc, err := EncodeBlock(block, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob, err := proto.Marshal(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc = new(Block)
+	if err := proto.Unmarshal(blob, enc); err != nil {
+		t.Fatal(err)
+	}
+	dec, err := DecodeBlock(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec.Hash() != block.Hash() {
+		t.Fatalf("block hash mismatch: have %x, want %x", dec.Hash(), block.Hash())
+	}
+	if !reflect.DeepEqual(dec.Header(), block.Header()) {
+		t.Fatalf("header mismatch:\nhave %+v\nwant %+v", dec.Header(), block.Header())
+	}
+	if len(dec.Transactions()) != 1 || dec.Transactions()[0].Hash() != tx.Hash() {
+		t.Fatal("transactions mismatch")
+	}
+	if !reflect.DeepEqual(dec.Withdrawals(), withdrawals) {
+		t.Fatalf("withdrawals mismatch: have %v, want %v", dec.Withdrawals(), withdrawals)
+	}
+
+	// Blocks without transaction bodies can't be decoded.
+	if enc, err = EncodeBlock(block, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeBlock(enc); err == nil {
+		t.Fatal("expected error for block without transaction bodies")
+	}
+	// Malformed fields are rejected.
+	enc, _ = EncodeBlock(block, true)
+	enc.Header.ParentHash = enc.Header.ParentHash[1:]
+	if _, err := DecodeBlock(enc); err == nil {
+		t.Fatal("expected error for truncated hash")
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package protorpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// unexpectedResult is returned if a method result has an unexpected type.
+func unexpectedResult(result interface{}) error {
+	return fmt.Errorf("unexpected result type %T", result)
+}
+
+func headerResult(c *call, result interface{}) (*Response, error) {
+	fields, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	header, err := encodeHeaderFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Result: &Response_Header{Header: header}}, nil
+}
+
+// blockResult converts a block, retrieving its uncles which are only referenced
+// by hash.
+func blockResult(c *call, result interface{}) (*Response, error) {
+	fields, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	header, err := encodeHeaderFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	enc := &Block{Header: header}
+
+	// Transactions are either all included or all referenced by hash.
+	txs, _ := fields["transactions"].([]interface{})
+	for i, tx := range txs {
+		switch tx := tx.(type) {
+		case common.Hash:
+			enc.TransactionHashes = append(enc.TransactionHashes, tx.Bytes())
+		case *ethapi.RPCTransaction:
+			blob, err := tx.Transaction().MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			enc.Transactions = append(enc.Transactions, blob)
+		default:
+			return nil, fmt.Errorf("unexpected type %T of transaction %d", tx, i)
+		}
+	}
+	uncles, _ := fields["uncles"].([]common.Hash)
+	if len(uncles) > 0 {
+		hash, ok := fields["hash"].(common.Hash)
+		if !ok {
+			return nil, fmt.Errorf("missing hash of block with uncles")
+		}
+		hashParam, _ := json.Marshal(hash)
+		for i := range uncles {
+			index, _ := json.Marshal(hexutil.Uint(i))
+			uncle, err := c.invoke("eth_getUncleByBlockHashAndIndex", hashParam, index)
+			if err != nil {
+				return nil, err
+			}
+			fields, ok := uncle.(map[string]interface{})
+			if !ok || fields == nil {
+				return nil, fmt.Errorf("missing uncle %d of block %x", i, hash)
+			}
+			header, err := encodeHeaderFields(fields)
+			if err != nil {
+				return nil, err
+			}
+			enc.Uncles = append(enc.Uncles, header)
+		}
+	}
+	if withdrawals, ok := fields["withdrawals"].(types.Withdrawals); ok {
+		enc.HasWithdrawals = true
+		enc.Withdrawals = encodeWithdrawals(withdrawals)
+	}
+	return &Response{Result: &Response_Block{Block: enc}}, nil
+}
+
+func receiptResult(c *call, result interface{}) (*Response, error) {
+	fields, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	receipt, err := encodeReceiptFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Result: &Response_Receipt{Receipt: receipt}}, nil
+}
+
+func receiptsResult(c *call, result interface{}) (*Response, error) {
+	receipts, ok := result.([]map[string]interface{})
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	enc := &Receipts{Receipts: make([]*Receipt, len(receipts))}
+	for i, fields := range receipts {
+		receipt, err := encodeReceiptFields(fields)
+		if err != nil {
+			return nil, err
+		}
+		enc.Receipts[i] = receipt
+	}
+	return &Response{Result: &Response_Receipts{Receipts: enc}}, nil
+}
+
+func logsResult(c *call, result interface{}) (*Response, error) {
+	stream, ok := result.(*rpc.ArrayStream[*types.Log])
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	logs, err := stream.Collect()
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Result: &Response_Logs{Logs: EncodeLogs(logs, "")}}, nil
+}
+
+func logPageResult(c *call, result interface{}) (*Response, error) {
+	page, ok := result.(*filters.LogPage)
+	if !ok {
+		return nil, unexpectedResult(result)
+	}
+	return &Response{Result: &Response_Logs{Logs: EncodeLogs(page.Logs, page.Cursor)}}, nil
+}
+
+// encodeHeaderFields converts a header in the RPC representation created by the
+// APIs of the node into its protobuf representation. Pending headers lack the
+// nonce and coinbase, which are left empty.
+func encodeHeaderFields(fields map[string]interface{}) (*Header, error) {
+	f := resultFields{fields: fields}
+	enc := &Header{
+		ParentHash:    f.hash("parentHash"),
+		UncleHash:     f.hash("sha3Uncles"),
+		Coinbase:      f.address("miner"),
+		Root:          f.hash("stateRoot"),
+		TxHash:        f.hash("transactionsRoot"),
+		ReceiptHash:   f.hash("receiptsRoot"),
+		Bloom:         f.bloom("logsBloom"),
+		Difficulty:    encodeBig(f.big("difficulty")),
+		GasLimit:      f.uint64("gasLimit"),
+		GasUsed:       f.uint64("gasUsed"),
+		Time:          f.uint64("timestamp"),
+		Extra:         f.bytes("extraData"),
+		MixDigest:     f.hash("mixHash"),
+		Nonce:         make([]byte, len(types.BlockNonce{})),
+		BaseFee:       encodeBig(f.big("baseFeePerGas")),
+		BlobGasUsed:   f.optUint64("blobGasUsed"),
+		ExcessBlobGas: f.optUint64("excessBlobGas"),
+	}
+	if number := f.big("number"); number != nil {
+		enc.Number = number.Uint64()
+	}
+	if nonce, ok := fields["nonce"].(types.BlockNonce); ok {
+		enc.Nonce = nonce[:]
+	}
+	if _, ok := fields["withdrawalsRoot"]; ok {
+		enc.WithdrawalsHash = f.hash("withdrawalsRoot")
+	}
+	if _, ok := fields["parentBeaconBlockRoot"]; ok {
+		enc.ParentBeaconRoot = f.hash("parentBeaconBlockRoot")
+	}
+	if _, ok := fields["requestsHash"]; ok {
+		enc.RequestsHash = f.hash("requestsHash")
+	}
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid header: %w", f.err)
+	}
+	return enc, nil
+}
+
+// encodeReceiptFields converts a receipt in the RPC representation created by the
+// APIs of the node into its protobuf representation.
+func encodeReceiptFields(fields map[string]interface{}) (*Receipt, error) {
+	f := resultFields{fields: fields}
+	enc := &Receipt{
+		Type:              uint32(f.uint64("type")),
+		PostState:         f.bytes("root"),
+		Status:            f.uint64("status"),
+		CumulativeGasUsed: f.uint64("cumulativeGasUsed"),
+		Bloom:             f.bloom("logsBloom"),
+		TxHash:            f.hash("transactionHash"),
+		ContractAddress:   f.address("contractAddress"),
+		GasUsed:           f.uint64("gasUsed"),
+		EffectiveGasPrice: encodeBig(f.big("effectiveGasPrice")),
+		BlobGasUsed:       f.uint64("blobGasUsed"),
+		BlobGasPrice:      encodeBig(f.big("blobGasPrice")),
+		BlockHash:         f.hash("blockHash"),
+		BlockNumber:       f.uint64("blockNumber"),
+		TransactionIndex:  uint32(f.uint64("transactionIndex")),
+	}
+	logs, ok := fields["logs"].([]*types.Log)
+	if !ok {
+		f.fail("logs")
+	}
+	enc.Logs = make([]*Log, len(logs))
+	for i, l := range logs {
+		enc.Logs[i] = EncodeLog(l)
+	}
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid receipt: %w", f.err)
+	}
+	return enc, nil
+}
+
+// resultFields reads the values of an object in the RPC representation, recording
+// the first field of unexpected type. Absent and null fields yield zero values.
+type resultFields struct {
+	fields map[string]interface{}
+	err    error
+}
+
+func (f *resultFields) fail(key string) {
+	if f.err == nil {
+		f.err = fmt.Errorf("field %q has unexpected type %T", key, f.fields[key])
+	}
+}
+
+func (f *resultFields) hash(key string) []byte {
+	switch v := f.fields[key].(type) {
+	case common.Hash:
+		return v.Bytes()
+	case *common.Hash:
+		if v != nil {
+			return v.Bytes()
+		}
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return common.Hash{}.Bytes()
+}
+
+func (f *resultFields) address(key string) []byte {
+	switch v := f.fields[key].(type) {
+	case common.Address:
+		return v.Bytes()
+	case *common.Address:
+		if v != nil {
+			return v.Bytes()
+		}
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return common.Address{}.Bytes()
+}
+
+func (f *resultFields) bloom(key string) []byte {
+	switch v := f.fields[key].(type) {
+	case types.Bloom:
+		return v.Bytes()
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return types.Bloom{}.Bytes()
+}
+
+func (f *resultFields) big(key string) *big.Int {
+	switch v := f.fields[key].(type) {
+	case *hexutil.Big:
+		return (*big.Int)(v)
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return nil
+}
+
+func (f *resultFields) uint64(key string) uint64 {
+	switch v := f.fields[key].(type) {
+	case hexutil.Uint64:
+		return uint64(v)
+	case hexutil.Uint:
+		return uint64(v)
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return 0
+}
+
+func (f *resultFields) optUint64(key string) *uint64 {
+	if _, ok := f.fields[key]; !ok {
+		return nil
+	}
+	v := f.uint64(key)
+	return &v
+}
+
+func (f *resultFields) bytes(key string) []byte {
+	switch v := f.fields[key].(type) {
+	case hexutil.Bytes:
+		return v
+	case nil:
+	default:
+		f.fail(key)
+	}
+	return nil
+}
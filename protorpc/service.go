@@ -0,0 +1,233 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package protorpc implements a binary transport for the RPC APIs of the node.
+//
+// Method calls are sent as protobuf messages over HTTP, with HTTP/2 being used
+// if the client supports it. Calls are executed in-process by the JSON-RPC server
+// of the HTTP endpoint, subject to its modules, access rules and rate limits.
+// Blocks, receipts and logs are returned as typed messages, which are encoded from
+// the results of the API methods directly and are cheaper to decode than their
+// JSON encoding. The results of all other methods are returned JSON encoded.
+package protorpc
+
+//go:generate protoc -I/usr/local/include:. --go_out=paths=source_relative:. protorpc.proto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ContentType is the media type of protobuf RPC requests and responses.
+	ContentType = "application/x-protobuf"
+
+	// maxRequestContentLength is the maximum size of a request body.
+	maxRequestContentLength = 5 * 1024 * 1024
+
+	errcodeDefault        = -32000
+	errcodeMethodNotFound = -32601
+)
+
+// SupportedModules are the API namespaces that may be served over protobuf RPC.
+var SupportedModules = []string{"eth", "debug", "txpool"}
+
+// resultFunc converts the result of a method into a typed response.
+type resultFunc func(c *call, result interface{}) (*Response, error)
+
+// handler serves protobuf RPC requests by calling the methods registered on the
+// JSON-RPC server of the HTTP endpoint, converting the results of some methods to
+// typed messages.
+type handler struct {
+	stack   *node.Node
+	modules map[string]bool
+	results map[string]resultFunc
+}
+
+// New registers the protobuf RPC handler on the HTTP server of the node, serving
+// the methods of the given API namespaces.
+func New(stack *node.Node, modules, cors, vhosts []string) error {
+	h, err := newHandler(stack, modules)
+	if err != nil {
+		return err
+	}
+	handler := node.NewHTTPHandlerStack(h, cors, vhosts, nil)
+	stack.RegisterHTTP2Handler("Protobuf RPC", "/protorpc", handler)
+	stack.RegisterHTTP2Handler("Protobuf RPC", "/protorpc/", handler)
+	return nil
+}
+
+func newHandler(stack *node.Node, modules []string) (*handler, error) {
+	h := &handler{
+		stack:   stack,
+		modules: make(map[string]bool),
+	}
+	for _, module := range modules {
+		if !slices.Contains(SupportedModules, module) {
+			return nil, fmt.Errorf("module %q not supported over protobuf RPC, want one of %v", module, SupportedModules)
+		}
+		h.modules[module] = true
+	}
+	h.results = map[string]resultFunc{
+		"eth_getHeaderByNumber":     headerResult,
+		"eth_getHeaderByHash":       headerResult,
+		"eth_getBlockByNumber":      blockResult,
+		"eth_getBlockByHash":        blockResult,
+		"eth_getTransactionReceipt": receiptResult,
+		"eth_getBlockReceipts":      receiptsResult,
+		"eth_getLogs":               logsResult,
+		"eth_getLogsPage":           logPageResult,
+	}
+	return h, nil
+}
+
+// ServeHTTP serves a single protobuf RPC request.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if mt, _, err := mime.ParseMediaType(r.Header.Get("content-type")); err != nil || mt != ContentType {
+		http.Error(w, "invalid content type, only "+ContentType+" is supported", http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxRequestContentLength {
+		http.Error(w, fmt.Sprintf("content length too large (%d>%d)", len(body), maxRequestContentLength), http.StatusRequestEntityTooLarge)
+		return
+	}
+	req := new(Request)
+	if err := proto.Unmarshal(body, req); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := h.call(r, req)
+	if err != nil {
+		res = &Response{Result: &Response_Error{Error: encodeError(err)}}
+	}
+	out, err := proto.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("content-type", ContentType)
+	w.Write(out)
+}
+
+// call executes a method call. A response without result stands for null.
+func (h *handler) call(r *http.Request, req *Request) (*Response, error) {
+	namespace, _, _ := strings.Cut(req.Method, "_")
+	if !h.modules[namespace] {
+		return nil, &callError{code: errcodeMethodNotFound, message: fmt.Sprintf("the method %s does not exist/is not available", req.Method)}
+	}
+	srv := h.stack.HTTPRPCServer()
+	if srv == nil {
+		return nil, errors.New("JSON-RPC over HTTP is not enabled")
+	}
+	params := make([]json.RawMessage, len(req.Params))
+	for i, param := range req.Params {
+		params[i] = param
+	}
+	c := &call{srv: srv, r: r}
+	result, err := c.invoke(req.Method, params...)
+	if err != nil {
+		return nil, err
+	}
+	if isNil(result) {
+		return new(Response), nil
+	}
+	if convert, ok := h.results[req.Method]; ok {
+		return convert(c, result)
+	}
+	var enc []byte
+	if stream, ok := result.(rpc.Stream); ok {
+		var buf bytes.Buffer
+		err = stream.EncodeJSON(&buf)
+		enc = buf.Bytes()
+	} else {
+		enc, err = json.Marshal(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Result: &Response_Json{Json: enc}}, nil
+}
+
+// call is a method call executed on behalf of the client of an HTTP request.
+type call struct {
+	srv *rpc.Server
+	r   *http.Request
+}
+
+// invoke calls a method of the JSON-RPC server, returning its result unencoded.
+func (c *call) invoke(method string, params ...json.RawMessage) (interface{}, error) {
+	return c.srv.CallHTTP(c.r, method, params)
+}
+
+// isNil reports whether a method result is encoded as JSON null.
+func isNil(result interface{}) bool {
+	v := reflect.ValueOf(result)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// callError is an error raised while executing a method call.
+type callError struct {
+	code    int
+	message string
+}
+
+func (e *callError) Error() string  { return e.message }
+func (e *callError) ErrorCode() int { return e.code }
+
+// encodeError converts the error of a failed method call into its protobuf
+// representation, retaining the code and data of RPC errors.
+func encodeError(err error) *Error {
+	enc := &Error{Code: errcodeDefault, Message: err.Error()}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		enc.Code = int32(rpcErr.ErrorCode())
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		if data, err := json.Marshal(dataErr.ErrorData()); err == nil {
+			enc.Data = data
+		}
+	}
+	return enc
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package protorpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/protoclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/protorpc"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+var (
+	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
+	testEmitter = common.HexToAddress("0x1000")
+)
+
+func newTestNode(t *testing.T, modules []string, httpModules []string, rules []rpc.AccessRule) (*node.Node, []*types.Block) {
+	stack, err := node.New(&node.Config{
+		HTTPHost:        "127.0.0.1",
+		HTTPPort:        0,
+		HTTPModules:     httpModules,
+		HTTPAccessRules: rules,
+		HTTPTimeouts:    node.DefaultConfig.HTTPTimeouts,
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	t.Cleanup(func() { stack.Close() })
+
+	config := *params.AllEthashProtocolChanges
+	gspec := &core.Genesis{
+		Config: &config,
+		Alloc: types.GenesisAlloc{
+			testAddr: {Balance: big.NewInt(params.Ether)},
+			// PUSH1 0 PUSH1 0 LOG0
+			testEmitter: {Code: common.FromHex("0x60006000a0")},
+		},
+	}
+	ethBackend, err := eth.New(stack, &ethconfig.Config{
+		Genesis:        gspec,
+		NetworkId:      1337,
+		TrieCleanCache: 5,
+		TrieDirtyCache: 5,
+		TrieTimeout:    60 * time.Minute,
+		SnapshotCache:  5,
+		RPCGasCap:      1000000,
+		StateScheme:    rawdb.HashScheme,
+	})
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	signer := types.LatestSigner(&config)
+	chain, _ := core.GenerateChain(&config, ethBackend.BlockChain().Genesis(), ethash.NewFaker(), ethBackend.ChainDb(), 4, func(i int, gen *core.BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx := types.MustSignNewTx(testKey, signer, &types.LegacyTx{
+				Nonce:    gen.TxNonce(testAddr),
+				To:       &testEmitter,
+				Gas:      50000,
+				GasPrice: gen.BaseFee(),
+			})
+			gen.AddTx(tx)
+		}
+	})
+	if _, err := ethBackend.BlockChain().InsertChain(chain); err != nil {
+		t.Fatalf("could not import blocks: %v", err)
+	}
+	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
+	stack.RegisterAPIs([]rpc.API{{Namespace: "eth", Service: filters.NewFilterAPI(filterSystem)}})
+	if err := protorpc.New(stack, modules, nil, []string{"*"}); err != nil {
+		t.Fatalf("could not create protorpc service: %v", err)
+	}
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	return stack, chain
+}
+
+func TestProtoRPC(t *testing.T) {
+	stack, chain := newTestNode(t, []string{"eth"}, nil, nil)
+
+	client, err := protoclient.Dial(stack.HTTPEndpoint() + "/protorpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	var (
+		ctx  = context.Background()
+		head = chain[len(chain)-1]
+	)
+	// Methods without typed results are answered with their JSON encoding.
+	number, err := client.BlockNumber(ctx)
+	if err != nil {
+		t.Fatalf("failed to retrieve block number: %v", err)
+	}
+	if number != head.NumberU64() {
+		t.Fatalf("block number mismatch: have %d, want %d", number, head.NumberU64())
+	}
+	// Blocks and headers are decoded from typed messages.
+	block, err := client.BlockByNumber(ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve head block: %v", err)
+	}
+	if block.Hash() != head.Hash() {
+		t.Fatalf("head block hash mismatch: have %x, want %x", block.Hash(), head.Hash())
+	}
+	if types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)) != head.TxHash() {
+		t.Fatal("head block transactions mismatch")
+	}
+	header, err := client.HeaderByHash(ctx, chain[1].Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve header: %v", err)
+	}
+	if header.Hash() != chain[1].Hash() {
+		t.Fatalf("header hash mismatch: have %x, want %x", header.Hash(), chain[1].Hash())
+	}
+	if _, err := client.BlockByHash(ctx, common.Hash{1}); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected not found error for unknown block, got %v", err)
+	}
+	// Receipts match the ones served over JSON-RPC.
+	receipts, err := client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(head.Hash(), false))
+	if err != nil {
+		t.Fatalf("failed to retrieve block receipts: %v", err)
+	}
+	if types.DeriveSha(types.Receipts(receipts), trie.NewStackTrie(nil)) != head.ReceiptHash() {
+		t.Fatal("block receipts mismatch")
+	}
+	jsonClient := ethclient.NewClient(stack.Attach())
+	want, err := jsonClient.TransactionReceipt(ctx, head.Transactions()[1].Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve JSON receipt: %v", err)
+	}
+	have, err := client.TransactionReceipt(ctx, head.Transactions()[1].Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve receipt: %v", err)
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("receipt mismatch:\nhave %+v\nwant %+v", have, want)
+	}
+	// Logs match the ones served over JSON-RPC, including pagination.
+	query := ethereum.FilterQuery{Addresses: []common.Address{testEmitter}}
+	wantLogs, err := jsonClient.FilterLogs(ctx, query)
+	if err != nil {
+		t.Fatalf("failed to retrieve JSON logs: %v", err)
+	}
+	if len(wantLogs) != 2*len(chain) {
+		t.Fatalf("wrong number of logs: %d", len(wantLogs))
+	}
+	logs, err := client.FilterLogs(ctx, query)
+	if err != nil {
+		t.Fatalf("failed to retrieve logs: %v", err)
+	}
+	if !reflect.DeepEqual(logs, wantLogs) {
+		t.Fatalf("logs mismatch:\nhave %+v\nwant %+v", logs, wantLogs)
+	}
+	query.Limit = 3
+	page, cursor, err := client.FilterLogsPage(ctx, query)
+	if err != nil {
+		t.Fatalf("failed to retrieve log page: %v", err)
+	}
+	if len(page) != 3 || cursor == "" {
+		t.Fatalf("wrong log page: %d logs, cursor %q", len(page), cursor)
+	}
+	// Methods outside of the served namespaces are rejected.
+	var info interface{}
+	err = client.CallContext(ctx, &info, "admin_nodeInfo")
+	if rpcErr, ok := err.(rpc.Error); !ok || rpcErr.ErrorCode() != -32601 {
+		t.Fatalf("expected method not found error, got %v", err)
+	}
+	// Errors of the node are passed through.
+	err = client.CallContext(ctx, nil, "eth_getBalance", "0xinvalid", "latest")
+	if rpcErr, ok := err.(rpc.Error); !ok || rpcErr.ErrorCode() != -32602 {
+		t.Fatalf("expected invalid params error, got %v", err)
+	}
+}
+
+func TestUnsupportedModule(t *testing.T) {
+	stack, err := node.New(&node.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+
+	if err := protorpc.New(stack, []string{"admin"}, nil, nil); err == nil {
+		t.Fatal("expected error for unsupported module")
+	}
+}
+
+// Tests that calls are subject to the modules and access rules of the HTTP
+// endpoint, including the ones with typed results.
+func TestHTTPEndpointRules(t *testing.T) {
+	rules := []rpc.AccessRule{{Deny: []string{"eth_getBlockByNumber"}}}
+	stack, _ := newTestNode(t, []string{"eth", "txpool"}, []string{"eth"}, rules)
+
+	client, err := protoclient.Dial(stack.HTTPEndpoint() + "/protorpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	if _, err := client.BlockByNumber(ctx, nil); err == nil {
+		t.Fatal("denied method served")
+	}
+	if _, err := client.HeaderByNumber(ctx, nil); err != nil {
+		t.Fatalf("allowed method not served: %v", err)
+	}
+	var status interface{}
+	err = client.CallContext(ctx, &status, "txpool_status")
+	if rpcErr, ok := err.(rpc.Error); !ok || rpcErr.ErrorCode() != -32601 {
+		t.Fatalf("expected method not found error for module outside of the HTTP API, got %v", err)
+	}
+}
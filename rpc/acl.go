@@ -0,0 +1,156 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AccessRule allows or denies methods to the clients it applies to.
+//
+// Methods are matched like in rate limit groups: a method ending in '*' matches all
+// methods starting with the given prefix, a lone '*' matches any method. If both
+// lists of a rule match a method, the more specific pattern decides, where an exact
+// name beats any prefix and a longer prefix beats a shorter one. Deny wins ties.
+type AccessRule struct {
+	// Subjects the rule applies to, matched against the authenticated subject of
+	// the client, e.g. the 'sub' claim of its JWT token. If empty, the rule applies
+	// to all clients.
+	//
+	// Subjects are only as trustworthy as their source. JWT tokens signed with a
+	// shared secret can claim any subject, so rules bound to them don't separate
+	// clients which know the secret.
+	Subjects []string `toml:",omitempty"`
+
+	// Allow lists the methods the rule grants access to.
+	Allow []string `toml:",omitempty"`
+
+	// Deny lists the methods the rule rejects.
+	Deny []string `toml:",omitempty"`
+}
+
+// AccessControl enforces method access rules on the requests of clients. The rules
+// are evaluated in order, the first rule applying to the client and matching the
+// method decides. Methods not matched by any rule are allowed.
+//
+// Access rules are checked in addition to the namespaces exposed by a server, they
+// can't grant access to methods of a namespace that isn't served.
+type AccessControl struct {
+	rules []*accessRule
+}
+
+type accessRule struct {
+	subjects []string
+	allow    methodPatterns
+	deny     methodPatterns
+}
+
+// methodPatterns is a set of method names and prefixes.
+type methodPatterns struct {
+	exact    map[string]struct{}
+	prefixes []string
+}
+
+// NewAccessControl creates an access control enforcing the given rules.
+func NewAccessControl(rules []AccessRule) (*AccessControl, error) {
+	ac := new(AccessControl)
+	for i, cfg := range rules {
+		if len(cfg.Allow) == 0 && len(cfg.Deny) == 0 {
+			return nil, fmt.Errorf("access rule %d has no methods", i)
+		}
+		if slices.Contains(cfg.Subjects, "") {
+			return nil, fmt.Errorf("access rule %d has empty subject", i)
+		}
+		allow, err := newMethodPatterns(cfg.Allow)
+		if err != nil {
+			return nil, fmt.Errorf("access rule %d: %v", i, err)
+		}
+		deny, err := newMethodPatterns(cfg.Deny)
+		if err != nil {
+			return nil, fmt.Errorf("access rule %d: %v", i, err)
+		}
+		ac.rules = append(ac.rules, &accessRule{
+			subjects: slices.Clone(cfg.Subjects),
+			allow:    allow,
+			deny:     deny,
+		})
+	}
+	return ac, nil
+}
+
+func newMethodPatterns(methods []string) (methodPatterns, error) {
+	p := methodPatterns{exact: make(map[string]struct{})}
+	for _, method := range methods {
+		if method == "" {
+			return p, errors.New("empty method")
+		}
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			if strings.Contains(prefix, "*") {
+				return p, fmt.Errorf("invalid method pattern %q", method)
+			}
+			p.prefixes = append(p.prefixes, prefix)
+		} else {
+			if strings.Contains(method, "*") {
+				return p, fmt.Errorf("invalid method pattern %q", method)
+			}
+			p.exact[method] = struct{}{}
+		}
+	}
+	return p, nil
+}
+
+// match returns the specificity of the most specific pattern matching the method,
+// or -1 if no pattern matches.
+func (p *methodPatterns) match(method string) int {
+	if _, ok := p.exact[method]; ok {
+		return len(method) + 1
+	}
+	best := -1
+	for _, prefix := range p.prefixes {
+		if len(prefix) > best && strings.HasPrefix(method, prefix) {
+			best = len(prefix)
+		}
+	}
+	return best
+}
+
+// check returns an error if the calling client may not access the method.
+func (ac *AccessControl) check(ctx context.Context, method string) error {
+	if !ac.allowed(PeerInfoFromContext(ctx).Subject, method) {
+		accessDeniedMeter.Mark(1)
+		return &accessDeniedError{method: method}
+	}
+	return nil
+}
+
+// allowed evaluates the rules for a client with the given subject.
+func (ac *AccessControl) allowed(subject, method string) bool {
+	for _, rule := range ac.rules {
+		if len(rule.subjects) > 0 && !slices.Contains(rule.subjects, subject) {
+			continue
+		}
+		allow, deny := rule.allow.match(method), rule.deny.match(method)
+		if allow >= 0 || deny >= 0 {
+			return allow > deny
+		}
+	}
+	return true
+}
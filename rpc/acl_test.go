@@ -0,0 +1,147 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAccessControlConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []AccessRule{
+		{},
+		{Subjects: []string{""}, Allow: []string{"*"}},
+		{Allow: []string{""}},
+		{Deny: []string{"debug_*trace"}},
+		{Deny: []string{"**"}},
+	}
+	for i, rule := range tests {
+		if _, err := NewAccessControl([]AccessRule{rule}); err == nil {
+			t.Errorf("test %d: expected error for invalid rule %+v", i, rule)
+		}
+	}
+}
+
+func TestAccessControlRules(t *testing.T) {
+	t.Parallel()
+
+	ac, err := NewAccessControl([]AccessRule{
+		{
+			Subjects: []string{"dashboard"},
+			Allow:    []string{"admin_peers", "admin_nodeInfo", "debug_trace*"},
+			Deny:     []string{"admin_*", "debug_*"},
+		},
+		{
+			Allow: []string{"debug_*"},
+			Deny:  []string{"debug_setHead", "admin_*"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		subject string
+		method  string
+		allowed bool
+	}{
+		{"dashboard", "admin_peers", true},
+		{"dashboard", "admin_nodeInfo", true},
+		{"dashboard", "admin_addPeer", false},
+		{"dashboard", "debug_traceTransaction", true},
+		{"dashboard", "debug_setHead", false},
+		{"dashboard", "eth_blockNumber", true},
+		{"", "debug_traceTransaction", true},
+		{"", "debug_getRawBlock", true},
+		{"", "debug_setHead", false},
+		{"", "admin_peers", false},
+		{"other", "admin_peers", false},
+		{"", "eth_blockNumber", true},
+	}
+	for _, test := range tests {
+		if have := ac.allowed(test.subject, test.method); have != test.allowed {
+			t.Errorf("subject %q, method %s: allowed %v, want %v", test.subject, test.method, have, test.allowed)
+		}
+	}
+}
+
+func TestServerAccessControl(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer()
+	defer server.Stop()
+
+	ac, err := NewAccessControl([]AccessRule{
+		{Subjects: []string{"admin"}, Allow: []string{"*"}},
+		{Allow: []string{"test_echo"}, Deny: []string{"test_*", "nftest_*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetAccessControl(ac)
+
+	httpsrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subject := r.Header.Get("X-Subject"); subject != "" {
+			r = r.WithContext(ContextWithSubject(r.Context(), subject))
+		}
+		server.ServeHTTP(w, r)
+	}))
+	defer httpsrv.Close()
+
+	dial := func(subject string) *Client {
+		client, err := DialOptions(context.Background(), httpsrv.URL, WithHeader("X-Subject", subject))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+	admin, user := dial("admin"), dial("")
+	defer admin.Close()
+	defer user.Close()
+
+	var result echoResult
+	if err := user.Call(&result, "test_echo", "x", 1); err != nil {
+		t.Fatalf("allowed call failed: %v", err)
+	}
+	err = user.Call(nil, "test_noArgsRets")
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32601 || err.Error() != "access to method test_noArgsRets denied" {
+		t.Fatalf("expected access denied error, got %v", err)
+	}
+	if err := admin.Call(nil, "test_noArgsRets"); err != nil {
+		t.Fatalf("call of privileged client failed: %v", err)
+	}
+
+	// Rules apply to every call of a batch.
+	batch := []BatchElem{
+		{Method: "test_echo", Args: []any{"x", 1}, Result: new(echoResult)},
+		{Method: "nftest_echo", Args: []any{1}, Result: new(int)},
+	}
+	if err := user.BatchCall(batch); err != nil {
+		t.Fatal(err)
+	}
+	if batch[0].Error != nil {
+		t.Fatalf("allowed batch call failed: %v", batch[0].Error)
+	}
+	if batch[1].Error == nil {
+		t.Fatal("denied batch call succeeded")
+	}
+}
@@ -0,0 +1,735 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// defaultHealthCheckInterval is the interval between endpoint health checks if
+// the balancer config doesn't set one.
+const defaultHealthCheckInterval = 5 * time.Second
+
+var (
+	errNoEndpoints     = errors.New("no endpoints given")
+	errNoEndpoint      = errors.New("no endpoint available")
+	errEndpointSyncing = errors.New("endpoint is syncing")
+	errFilterNotFound  = errors.New("filter not found")
+)
+
+// Methods creating a filter, and the methods referring to one by its ID. Filters
+// only exist on the endpoint that created them.
+var (
+	balancerFilterCreators = map[string]bool{
+		"eth_newFilter":                   true,
+		"eth_newBlockFilter":              true,
+		"eth_newPendingTransactionFilter": true,
+	}
+	balancerFilterMethods = map[string]bool{
+		"eth_getFilterChanges": true,
+		"eth_getFilterLogs":    true,
+		"eth_uninstallFilter":  true,
+	}
+)
+
+// balancerReadMethods are the read-only methods retried on another endpoint if
+// the transport fails. Any other request may have been executed by the failed
+// endpoint, so it is sent exactly once.
+var balancerReadMethods = map[string]bool{
+	"eth_blockNumber":                         true,
+	"eth_chainId":                             true,
+	"eth_syncing":                             true,
+	"eth_gasPrice":                            true,
+	"eth_maxPriorityFeePerGas":                true,
+	"eth_blobBaseFee":                         true,
+	"eth_feeHistory":                          true,
+	"eth_getBalance":                          true,
+	"eth_getCode":                             true,
+	"eth_getStorageAt":                        true,
+	"eth_getTransactionCount":                 true,
+	"eth_getProof":                            true,
+	"eth_call":                                true,
+	"eth_simulateV1":                          true,
+	"eth_estimateGas":                         true,
+	"eth_estimateGasBundle":                   true,
+	"eth_createAccessList":                    true,
+	"eth_getHeaderByNumber":                   true,
+	"eth_getHeaderByHash":                     true,
+	"eth_getBlockByNumber":                    true,
+	"eth_getBlockByHash":                      true,
+	"eth_getBlockReceipts":                    true,
+	"eth_getBlockTransactionCountByNumber":    true,
+	"eth_getBlockTransactionCountByHash":      true,
+	"eth_getUncleByBlockNumberAndIndex":       true,
+	"eth_getUncleByBlockHashAndIndex":         true,
+	"eth_getUncleCountByBlockNumber":          true,
+	"eth_getUncleCountByBlockHash":            true,
+	"eth_getTransactionByHash":                true,
+	"eth_getTransactionByBlockNumberAndIndex": true,
+	"eth_getTransactionByBlockHashAndIndex":   true,
+	"eth_getRawTransactionByHash":             true,
+	"eth_getTransactionReceipt":               true,
+	"eth_getLogs":                             true,
+	"eth_getLogsPage":                         true,
+	"net_version":                             true,
+	"net_listening":                           true,
+	"net_peerCount":                           true,
+	"web3_clientVersion":                      true,
+	"web3_sha3":                               true,
+}
+
+// BalancerConfig configures a client that balances requests across multiple
+// endpoints.
+type BalancerConfig struct {
+	// HealthCheckInterval is the interval between endpoint health checks.
+	HealthCheckInterval time.Duration
+
+	// MaxHeadLag is the number of blocks an endpoint may trail the best endpoint
+	// and still serve reads.
+	MaxHeadLag uint64
+
+	// HealthCheck reports the head block number of an endpoint, or an error if
+	// the endpoint is unhealthy. The default check requires the endpoint to be in
+	// sync and reports the result of eth_blockNumber.
+	HealthCheck func(ctx context.Context, c *Client) (uint64, error)
+}
+
+// DialBalanced creates a client that balances requests across the given endpoints.
+// The returned client can be used like any other, e.g. with ethclient.NewClient.
+//
+// Requests are routed to the healthy endpoints closest to the best head. Read-only
+// methods are retried on another endpoint if the transport fails, any other request
+// is sent exactly once. Methods sending transactions are
+// pinned to a single endpoint as long as it stays healthy, and filters are pinned
+// to the endpoint they were created on. Subscriptions move to another endpoint when
+// their endpoint fails, notifications may be lost or duplicated while moving.
+//
+// The options apply to the connections of the individual endpoints. Endpoints that
+// can't be dialed are retried during the health checks.
+func DialBalanced(ctx context.Context, urls []string, config *BalancerConfig, options ...ClientOption) (*Client, error) {
+	if len(urls) == 0 {
+		return nil, errNoEndpoints
+	}
+	b := &balancer{
+		options: options,
+		filters: make(map[string]*balancerEndpoint),
+		subs:    make(map[ID]*balancedSub),
+		idgen:   randomIDGenerator(),
+		out:     make(chan *jsonrpcMessage),
+		closeCh: make(chan interface{}),
+	}
+	if config != nil {
+		b.config = *config
+	}
+	if b.config.HealthCheckInterval == 0 {
+		b.config.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if b.config.HealthCheck == nil {
+		b.config.HealthCheck = checkEndpointHealth
+	}
+	var (
+		dialed  int
+		dialErr error
+	)
+	for _, url := range urls {
+		ep := &balancerEndpoint{url: url}
+		if c, err := DialOptions(ctx, url, options...); err != nil {
+			log.Warn("Failed to dial RPC endpoint", "url", url, "err", err)
+			dialErr = err
+		} else {
+			ep.client = c
+			dialed++
+		}
+		b.endpoints = append(b.endpoints, ep)
+	}
+	if dialed == 0 {
+		return nil, dialErr
+	}
+	b.checkHealth(ctx)
+	go b.loop()
+
+	c, err := newClient(ctx, new(clientConfig), b.connect)
+	if err != nil {
+		b.close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkEndpointHealth is the default endpoint health check.
+func checkEndpointHealth(ctx context.Context, c *Client) (uint64, error) {
+	var (
+		syncing json.RawMessage
+		head    hexutil.Uint64
+	)
+	batch := []BatchElem{
+		{Method: "eth_syncing", Result: &syncing},
+		{Method: "eth_blockNumber", Result: &head},
+	}
+	if err := c.BatchCallContext(ctx, batch); err != nil {
+		return 0, err
+	}
+	for _, elem := range batch {
+		if elem.Error != nil {
+			return 0, elem.Error
+		}
+	}
+	if string(syncing) != "false" {
+		return 0, errEndpointSyncing
+	}
+	return uint64(head), nil
+}
+
+// balancerEndpoint is a single endpoint of a balancer.
+type balancerEndpoint struct {
+	url     string
+	client  *Client // nil until the endpoint was dialed successfully
+	head    uint64  // head block number reported by the last health check
+	healthy bool
+}
+
+// balancedSub is a subscription of the client, served by one of the endpoints.
+type balancedSub struct {
+	id        ID
+	namespace string
+	args      []json.RawMessage
+	quit      chan struct{} // closed when the client unsubscribes
+
+	// Fields of the endpoint subscription, owned by the forwarding goroutine and
+	// written with the balancer lock held.
+	ep      *balancerEndpoint
+	backend *ClientSubscription
+	ch      chan json.RawMessage
+}
+
+// notification creates the notification message of a subscription result.
+func (sub *balancedSub) notification(result json.RawMessage) *jsonrpcMessage {
+	params, _ := json.Marshal(subscriptionResult{ID: string(sub.id), Result: result})
+	return &jsonrpcMessage{Version: vsn, Method: sub.namespace + notificationMethodSuffix, Params: params}
+}
+
+// balancer is the connection of a client balancing requests across multiple
+// endpoints. It implements ServerCodec, forwarding the messages written by the
+// client to the endpoints and handing their responses back to it.
+type balancer struct {
+	config    BalancerConfig
+	options   []ClientOption
+	endpoints []*balancerEndpoint
+	idgen     func() ID
+
+	mu      sync.Mutex
+	next    int                          // round-robin counter of reads
+	pinned  *balancerEndpoint            // endpoint transactions are sent to
+	filters map[string]*balancerEndpoint // endpoints of installed filters
+	subs    map[ID]*balancedSub          // active subscriptions of the client
+	stopped bool
+
+	out       chan *jsonrpcMessage // responses and notifications for the client
+	closeCh   chan interface{}
+	closeOnce sync.Once
+}
+
+// connect hands the balancer to the client as its connection.
+func (b *balancer) connect(context.Context) (ServerCodec, error) {
+	select {
+	case <-b.closeCh:
+		return nil, ErrClientQuit
+	default:
+		return b, nil
+	}
+}
+
+// loop runs the periodic health checks until the balancer is closed.
+func (b *balancer) loop() {
+	ticker := time.NewTicker(b.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), b.config.HealthCheckInterval)
+			b.checkHealth(ctx)
+			cancel()
+		case <-b.closeCh:
+			return
+		}
+	}
+}
+
+// checkHealth checks all endpoints concurrently, dialing the ones that have no
+// connection yet.
+func (b *balancer) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range b.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.checkEndpoint(ctx, ep)
+		}()
+	}
+	wg.Wait()
+}
+
+func (b *balancer) checkEndpoint(ctx context.Context, ep *balancerEndpoint) {
+	b.mu.Lock()
+	client := ep.client
+	b.mu.Unlock()
+
+	if client == nil {
+		c, err := DialOptions(ctx, ep.url, b.options...)
+		if err != nil {
+			b.setHealth(ep, 0, err)
+			return
+		}
+		b.mu.Lock()
+		if b.stopped {
+			b.mu.Unlock()
+			c.Close()
+			return
+		}
+		ep.client = c
+		b.mu.Unlock()
+		client = c
+	}
+	head, err := b.config.HealthCheck(ctx, client)
+	b.setHealth(ep, head, err)
+}
+
+// setHealth records the result of a health check.
+func (b *balancer) setHealth(ep *balancerEndpoint, head uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err != nil {
+		if ep.healthy {
+			log.Warn("RPC endpoint became unhealthy", "url", ep.url, "err", err)
+		}
+		ep.healthy = false
+		return
+	}
+	if !ep.healthy {
+		log.Debug("RPC endpoint is healthy", "url", ep.url, "head", head)
+	}
+	ep.head, ep.healthy = head, true
+}
+
+// markFailed marks an endpoint unhealthy after a transport failure, until the next
+// health check succeeds.
+func (b *balancer) markFailed(ep *balancerEndpoint, err error) {
+	b.setHealth(ep, 0, err)
+}
+
+// pick selects the endpoint serving a read, skipping the excluded ones. Healthy
+// endpoints within the allowed lag of the best head are used round-robin. If there
+// is no such endpoint, the lagging and then the unhealthy ones are tried.
+func (b *balancer) pick(exclude []*balancerEndpoint) *balancerEndpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pickLocked(exclude)
+}
+
+func (b *balancer) pickLocked(exclude []*balancerEndpoint) *balancerEndpoint {
+	var best uint64
+	for _, ep := range b.endpoints {
+		if ep.healthy && ep.head > best {
+			best = ep.head
+		}
+	}
+	var synced, lagging, unhealthy []*balancerEndpoint
+	for _, ep := range b.endpoints {
+		switch {
+		case ep.client == nil || slices.Contains(exclude, ep):
+		case !ep.healthy:
+			unhealthy = append(unhealthy, ep)
+		case ep.head+b.config.MaxHeadLag < best:
+			lagging = append(lagging, ep)
+		default:
+			synced = append(synced, ep)
+		}
+	}
+	for _, eps := range [][]*balancerEndpoint{synced, lagging, unhealthy} {
+		if len(eps) > 0 {
+			b.next++
+			return eps[b.next%len(eps)]
+		}
+	}
+	return nil
+}
+
+// pin returns the endpoint transactions are sent to, selecting a new one if the
+// current endpoint became unhealthy.
+func (b *balancer) pin() *balancerEndpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pinned == nil || !b.pinned.healthy {
+		if ep := b.pickLocked(nil); ep != nil && (b.pinned == nil || ep.healthy) {
+			b.pinned = ep
+		}
+	}
+	return b.pinned
+}
+
+// call forwards a request to an endpoint.
+func (b *balancer) call(ctx context.Context, ep *balancerEndpoint, method string, args []json.RawMessage) (json.RawMessage, error) {
+	if ep == nil {
+		return nil, errNoEndpoint
+	}
+	var result json.RawMessage
+	err := ep.client.CallContext(ctx, &result, method, balancerParams(args)...)
+	if err != nil && isTransportError(ctx, err) {
+		b.markFailed(ep, err)
+	}
+	return result, err
+}
+
+// callRetry forwards a read-only request, retrying on another endpoint if the
+// transport fails.
+func (b *balancer) callRetry(ctx context.Context, method string, args []json.RawMessage) (json.RawMessage, *balancerEndpoint, error) {
+	var (
+		tried []*balancerEndpoint
+		err   error
+	)
+	for {
+		ep := b.pick(tried)
+		if ep == nil {
+			if err == nil {
+				err = errNoEndpoint
+			}
+			return nil, nil, err
+		}
+		var result json.RawMessage
+		if result, err = b.call(ctx, ep, method, args); err == nil || !isTransportError(ctx, err) {
+			return result, ep, err
+		}
+		tried = append(tried, ep)
+	}
+}
+
+// isTransportError reports whether a failed request may succeed on another
+// endpoint. Errors returned by the endpoint itself are final.
+func isTransportError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var rpcErr Error
+	return !errors.As(err, &rpcErr) && !errors.Is(err, ErrNoResult)
+}
+
+// balancerParams converts the positional arguments of a request for forwarding.
+func balancerParams(args []json.RawMessage) []interface{} {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		params[i] = arg
+	}
+	return params
+}
+
+// handle serves a message written by the client.
+func (b *balancer) handle(ctx context.Context, msg *jsonrpcMessage) {
+	if !msg.isCall() && !msg.isNotification() {
+		return // responses to calls of the endpoints aren't forwarded
+	}
+	var args []json.RawMessage
+	if len(msg.Params) > 0 && string(msg.Params) != "null" {
+		if err := json.Unmarshal(msg.Params, &args); err != nil {
+			if msg.isCall() {
+				b.deliver(msg.errorResponse(&invalidParamsError{"non-array args"}))
+			}
+			return
+		}
+	}
+	if msg.isNotification() {
+		if ep := b.pick(nil); ep != nil {
+			if err := ep.client.Notify(ctx, msg.Method, balancerParams(args)...); err != nil {
+				log.Debug("Failed to forward RPC notification", "url", ep.url, "method", msg.Method, "err", err)
+			}
+		}
+		return
+	}
+	var (
+		result json.RawMessage
+		err    error
+	)
+	switch {
+	case msg.isSubscribe():
+		b.subscribe(ctx, msg, args)
+		return
+	case msg.isUnsubscribe():
+		b.deliver(b.unsubscribe(msg, args))
+		return
+	case strings.HasPrefix(msg.Method, "eth_send"):
+		result, err = b.call(ctx, b.pin(), msg.Method, args)
+	case balancerFilterCreators[msg.Method]:
+		result, err = b.newFilter(ctx, msg.Method, args)
+	case balancerFilterMethods[msg.Method]:
+		result, err = b.filterCall(ctx, msg.Method, args)
+	case balancerReadMethods[msg.Method]:
+		result, _, err = b.callRetry(ctx, msg.Method, args)
+	default:
+		result, err = b.call(ctx, b.pick(nil), msg.Method, args)
+	}
+	if err != nil {
+		b.deliver(msg.errorResponse(err))
+		return
+	}
+	b.deliver(&jsonrpcMessage{Version: vsn, ID: msg.ID, Result: result})
+}
+
+// newFilter installs a filter, remembering the endpoint it was created on.
+func (b *balancer) newFilter(ctx context.Context, method string, args []json.RawMessage) (json.RawMessage, error) {
+	ep := b.pick(nil)
+	result, err := b.call(ctx, ep, method, args)
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	if err := json.Unmarshal(result, &id); err == nil {
+		b.mu.Lock()
+		b.filters[id] = ep
+		b.mu.Unlock()
+	}
+	return result, nil
+}
+
+// filterCall forwards a request referring to a filter to the endpoint the filter
+// was created on.
+func (b *balancer) filterCall(ctx context.Context, method string, args []json.RawMessage) (json.RawMessage, error) {
+	var id string
+	if len(args) == 0 || json.Unmarshal(args[0], &id) != nil {
+		return nil, &invalidParamsError{"expected filter id as first argument"}
+	}
+	b.mu.Lock()
+	ep := b.filters[id]
+	b.mu.Unlock()
+	if ep == nil {
+		return nil, errFilterNotFound
+	}
+	result, err := b.call(ctx, ep, method, args)
+	if method == "eth_uninstallFilter" || (err != nil && err.Error() == errFilterNotFound.Error()) {
+		// Forget filters removed or expired on the endpoint.
+		b.mu.Lock()
+		delete(b.filters, id)
+		b.mu.Unlock()
+	}
+	return result, err
+}
+
+// subscribe creates a subscription on one of the endpoints and starts forwarding
+// its notifications.
+func (b *balancer) subscribe(ctx context.Context, msg *jsonrpcMessage, args []json.RawMessage) {
+	sub := &balancedSub{
+		id:        b.idgen(),
+		namespace: msg.namespace(),
+		args:      args,
+		quit:      make(chan struct{}),
+	}
+	if err := b.subscribeBackend(ctx, sub); err != nil {
+		b.deliver(msg.errorResponse(err))
+		return
+	}
+	b.mu.Lock()
+	b.subs[sub.id] = sub
+	b.mu.Unlock()
+
+	// The response must reach the client before the first notification.
+	if !b.deliver(msg.response(sub.id)) {
+		sub.backend.Unsubscribe()
+		return
+	}
+	go b.forward(sub)
+}
+
+// subscribeBackend subscribes on one of the endpoints, retrying on another one
+// if the transport fails.
+func (b *balancer) subscribeBackend(ctx context.Context, sub *balancedSub) error {
+	var (
+		tried []*balancerEndpoint
+		err   error
+	)
+	for {
+		ep := b.pick(tried)
+		if ep == nil {
+			if err == nil {
+				err = errNoEndpoint
+			}
+			return err
+		}
+		ch := make(chan json.RawMessage)
+		var backend *ClientSubscription
+		if backend, err = ep.client.Subscribe(ctx, sub.namespace, ch, balancerParams(sub.args)...); err == nil {
+			b.mu.Lock()
+			sub.ep, sub.backend, sub.ch = ep, backend, ch
+			b.mu.Unlock()
+			return nil
+		}
+		if !isTransportError(ctx, err) {
+			return err
+		}
+		b.markFailed(ep, err)
+		tried = append(tried, ep)
+	}
+}
+
+// forward delivers the notifications of a subscription to the client, moving the
+// subscription to another endpoint if its endpoint fails.
+func (b *balancer) forward(sub *balancedSub) {
+	for {
+		select {
+		case result := <-sub.ch:
+			b.deliver(sub.notification(result))
+		case err := <-sub.backend.Err():
+			log.Debug("RPC subscription failed, moving to another endpoint", "url", sub.ep.url, "id", sub.id, "err", err)
+			b.markFailed(sub.ep, err)
+			if !b.resubscribe(sub) {
+				return
+			}
+		case <-sub.quit:
+			sub.backend.Unsubscribe()
+			return
+		case <-b.closeCh:
+			return
+		}
+	}
+}
+
+// resubscribe re-establishes a subscription on another endpoint with exponential
+// backoff, until it succeeds or the subscription ends.
+func (b *balancer) resubscribe(sub *balancedSub) bool {
+	backoff := minReconnectBackoff
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+		err := b.subscribeBackend(ctx, sub)
+		cancel()
+		if err == nil {
+			return true
+		}
+		log.Debug("Failed to move RPC subscription", "id", sub.id, "backoff", backoff, "err", err)
+		select {
+		case <-time.After(backoff):
+		case <-sub.quit:
+			return false
+		case <-b.closeCh:
+			return false
+		}
+		backoff = min(2*backoff, maxReconnectBackoff)
+	}
+}
+
+// unsubscribe ends a subscription of the client.
+func (b *balancer) unsubscribe(msg *jsonrpcMessage, args []json.RawMessage) *jsonrpcMessage {
+	var id ID
+	if len(args) == 0 || json.Unmarshal(args[0], &id) != nil {
+		return msg.errorResponse(&invalidParamsError{"expected subscription id as first argument"})
+	}
+	b.mu.Lock()
+	sub := b.subs[id]
+	delete(b.subs, id)
+	b.mu.Unlock()
+
+	if sub == nil {
+		return msg.errorResponse(ErrSubscriptionNotFound)
+	}
+	close(sub.quit)
+	return msg.response(true)
+}
+
+// deliver hands a message to the client. It returns false if the balancer was
+// closed in the meantime.
+func (b *balancer) deliver(msg *jsonrpcMessage) bool {
+	select {
+	case b.out <- msg:
+		return true
+	case <-b.closeCh:
+		return false
+	}
+}
+
+func (b *balancer) peerInfo() PeerInfo {
+	return PeerInfo{Transport: "balancer", RemoteAddr: b.remoteAddr()}
+}
+
+func (b *balancer) remoteAddr() string {
+	urls := make([]string, len(b.endpoints))
+	for i, ep := range b.endpoints {
+		urls[i] = ep.url
+	}
+	return strings.Join(urls, ",")
+}
+
+func (b *balancer) readBatch() ([]*jsonrpcMessage, bool, error) {
+	select {
+	case msg := <-b.out:
+		return []*jsonrpcMessage{msg}, false, nil
+	case <-b.closeCh:
+		return nil, false, io.EOF
+	}
+}
+
+// writeJSON forwards the messages written by the client. Every message is served
+// on its own goroutine, the responses are read back through readBatch.
+func (b *balancer) writeJSON(ctx context.Context, v interface{}, isError bool) error {
+	select {
+	case <-b.closeCh:
+		return ErrClientQuit
+	default:
+	}
+	switch v := v.(type) {
+	case *jsonrpcMessage:
+		go b.handle(ctx, v)
+	case []*jsonrpcMessage:
+		for _, msg := range v {
+			go b.handle(ctx, msg)
+		}
+	}
+	return nil
+}
+
+func (b *balancer) closed() <-chan interface{} {
+	return b.closeCh
+}
+
+func (b *balancer) close() {
+	b.closeOnce.Do(func() {
+		close(b.closeCh)
+
+		b.mu.Lock()
+		b.stopped = true
+		var clients []*Client
+		for _, ep := range b.endpoints {
+			if ep.client != nil {
+				clients = append(clients, ep.client)
+			}
+		}
+		b.mu.Unlock()
+
+		for _, c := range clients {
+			c.Close()
+		}
+	})
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// balancerTestService is the eth namespace of a balanced endpoint.
+type balancerTestService struct {
+	name    string
+	head    atomic.Uint64
+	filters atomic.Uint64
+}
+
+func (s *balancerTestService) Syncing() bool               { return false }
+func (s *balancerTestService) BlockNumber() hexutil.Uint64 { return hexutil.Uint64(s.head.Load()) }
+func (s *balancerTestService) ChainId() string             { return s.name }
+func (s *balancerTestService) Endpoint() string            { return s.name }
+
+func (s *balancerTestService) SendRawTransaction(tx hexutil.Bytes) string {
+	return s.name
+}
+
+func (s *balancerTestService) NewBlockFilter() string {
+	return fmt.Sprintf("%s-%d", s.name, s.filters.Add(1))
+}
+
+func (s *balancerTestService) GetFilterChanges(id string) (string, error) {
+	if !strings.HasPrefix(id, s.name+"-") {
+		return "", errors.New("filter not found")
+	}
+	return s.name, nil
+}
+
+func (s *balancerTestService) Fail() error {
+	return testError{}
+}
+
+type balancerTestEndpoint struct {
+	service *balancerTestService
+	server  *Server
+	httpsrv *httptest.Server
+	url     string
+}
+
+func newBalancerTestEndpoint(t *testing.T, name string, head uint64) *balancerTestEndpoint {
+	t.Helper()
+
+	service := &balancerTestService{name: name}
+	service.head.Store(head)
+	server := newTestServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	httpsrv := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	ep := &balancerTestEndpoint{
+		service: service,
+		server:  server,
+		httpsrv: httpsrv,
+		url:     "ws:" + strings.TrimPrefix(httpsrv.URL, "http:"),
+	}
+	t.Cleanup(ep.stop)
+	return ep
+}
+
+func (ep *balancerTestEndpoint) stop() {
+	ep.server.Stop()
+	ep.httpsrv.Close()
+}
+
+func TestBalancer(t *testing.T) {
+	var (
+		a = newBalancerTestEndpoint(t, "a", 100)
+		b = newBalancerTestEndpoint(t, "b", 100)
+	)
+	client, err := DialBalanced(context.Background(), []string{a.url, b.url}, &BalancerConfig{
+		HealthCheckInterval: time.Hour,
+		MaxHeadLag:          2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	balancer := client.writeConn.(*balancer)
+
+	endpoints := func(n int) map[string]int {
+		t.Helper()
+		seen := make(map[string]int)
+		for i := 0; i < n; i++ {
+			var name string
+			if err := client.Call(&name, "eth_chainId"); err != nil {
+				t.Fatal(err)
+			}
+			seen[name]++
+		}
+		return seen
+	}
+	// Reads are spread across the synced endpoints.
+	if seen := endpoints(10); seen["a"] != 5 || seen["b"] != 5 {
+		t.Fatalf("reads not balanced: %v", seen)
+	}
+	// Endpoints lagging too far behind don't serve reads.
+	b.service.head.Store(97)
+	balancer.checkHealth(context.Background())
+	if seen := endpoints(10); seen["a"] != 10 {
+		t.Fatalf("reads served by lagging endpoint: %v", seen)
+	}
+	b.service.head.Store(98)
+	balancer.checkHealth(context.Background())
+	if seen := endpoints(10); seen["b"] == 0 {
+		t.Fatalf("reads not served by endpoint within lag: %v", seen)
+	}
+
+	// Errors returned by an endpoint are not retried.
+	if err := client.Call(nil, "eth_fail"); err == nil || err.Error() != (testError{}).Error() {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	// Transactions are sent to a single endpoint.
+	var pinned string
+	for i := 0; i < 5; i++ {
+		var name string
+		if err := client.Call(&name, "eth_sendRawTransaction", hexutil.Bytes{0x01}); err != nil {
+			t.Fatal(err)
+		}
+		if pinned == "" {
+			pinned = name
+		} else if name != pinned {
+			t.Fatalf("transaction sent to %s, pinned endpoint %s", name, pinned)
+		}
+	}
+
+	// Filters are served by the endpoint that created them.
+	var filters []string
+	for i := 0; i < 2; i++ {
+		var id string
+		if err := client.Call(&id, "eth_newBlockFilter"); err != nil {
+			t.Fatal(err)
+		}
+		filters = append(filters, id)
+	}
+	for i := 0; i < 5; i++ {
+		for _, id := range filters {
+			var name string
+			if err := client.Call(&name, "eth_getFilterChanges", id); err != nil {
+				t.Fatalf("filter %s: %v", id, err)
+			}
+			if !strings.HasPrefix(id, name+"-") {
+				t.Fatalf("filter %s served by endpoint %s", id, name)
+			}
+		}
+	}
+	if err := client.Call(nil, "eth_getFilterChanges", "unknown"); err == nil || err.Error() != errFilterNotFound.Error() {
+		t.Fatalf("wrong error for unknown filter: %v", err)
+	}
+
+	// Reads fail over to the remaining endpoint.
+	a.stop()
+	if seen := endpoints(5); seen["b"] != 5 {
+		t.Fatalf("reads not failed over: %v", seen)
+	}
+
+	// Other requests are not retried if the transport fails, as the endpoint may
+	// have executed them.
+	balancer.setHealth(balancer.endpoints[0], 100, nil)
+	var failed int
+	for i := 0; i < 2; i++ {
+		var name string
+		if err := client.Call(&name, "eth_endpoint"); err != nil {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Fatalf("wrong number of failed requests: have %d, want 1", failed)
+	}
+}
+
+func TestBalancerSubscription(t *testing.T) {
+	var (
+		a = newBalancerTestEndpoint(t, "a", 100)
+		b = newBalancerTestEndpoint(t, "b", 100)
+	)
+	client, err := DialBalanced(context.Background(), []string{a.url, b.url}, &BalancerConfig{
+		HealthCheckInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	balancer := client.writeConn.(*balancer)
+
+	ch := make(chan int)
+	sub, err := client.Subscribe(context.Background(), "nftest", ch, "someSubscription", 1, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	expect := func() {
+		t.Helper()
+		select {
+		case v := <-ch:
+			if v != 42 {
+				t.Fatalf("wrong notification: %d", v)
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+	expect()
+
+	// Stop the endpoint serving the subscription, it should move to the other one
+	// and receive the notification of the new subscription.
+	balancer.mu.Lock()
+	var serving string
+	for _, s := range balancer.subs {
+		serving = s.ep.url
+	}
+	balancer.mu.Unlock()
+	if serving == a.url {
+		a.stop()
+	} else {
+		b.stop()
+	}
+	expect()
+}
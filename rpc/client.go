@@ -21,9 +21,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -45,6 +47,9 @@ const (
 	defaultDialTimeout = 10 * time.Second // used if context has no deadline
 	subscribeTimeout   = 10 * time.Second // overall timeout eth_subscribe, rpc_modules calls
 	unsubscribeTimeout = 10 * time.Second // timeout for *_unsubscribe calls
+
+	minReconnectBackoff = 100 * time.Millisecond // initial delay between redial attempts
+	maxReconnectBackoff = 30 * time.Second       // maximum delay between redial attempts
 )
 
 const (
@@ -84,10 +89,15 @@ type Client struct {
 
 	// This function, if non-nil, is called when the connection is lost.
 	reconnectFunc reconnectFunc
+	autoReconnect bool                     // redial and resubscribe as soon as the connection is lost
+	suspend       chan *ClientSubscription // subscriptions to resubscribe after a failed attempt
 
 	// config fields
 	batchItemLimit       int
 	batchResponseMaxSize int
+	rateLimiter          *RateLimiter
+	accessControl        *AccessControl
+	recorder             *Recorder
 
 	// writeConn is used for writing to the connection on the caller's goroutine. It should
 	// only be accessed outside of dispatch, with the write lock held. The write lock is
@@ -120,6 +130,9 @@ func (c *Client) newClientConn(conn ServerCodec) *clientConn {
 	ctx = context.WithValue(ctx, clientContextKey{}, c)
 	ctx = context.WithValue(ctx, peerInfoContextKey{}, conn.peerInfo())
 	handler := newHandler(ctx, conn, c.idgen, c.services, c.batchItemLimit, c.batchResponseMaxSize, nil)
+	handler.rateLimiter = c.rateLimiter
+	handler.accessControl = c.accessControl
+	handler.recorder = c.recorder
 	return &clientConn{conn, handler}
 }
 
@@ -140,6 +153,7 @@ type requestOp struct {
 	err         error
 	resp        chan []*jsonrpcMessage // the response goes here
 	sub         *ClientSubscription    // set for Subscribe requests.
+	resume      bool                   // set when resubscribing after a reconnect
 	hadResponse bool                   // true when the request was responded to
 }
 
@@ -234,12 +248,12 @@ func newClient(initctx context.Context, cfg *clientConfig, connect reconnectFunc
 	if err != nil {
 		return nil, err
 	}
-	c := initClient(conn, new(serviceRegistry), cfg)
-	c.reconnectFunc = connect
-	return c, nil
+	return initClient(conn, new(serviceRegistry), cfg, connect), nil
 }
 
-func initClient(conn ServerCodec, services *serviceRegistry, cfg *clientConfig) *Client {
+// initClient creates a client on the given connection. Clients without a connect
+// function can't reconnect, so auto reconnection is disabled for them.
+func initClient(conn ServerCodec, services *serviceRegistry, cfg *clientConfig, connect reconnectFunc) *Client {
 	_, isHTTP := conn.(*httpConn)
 	c := &Client{
 		isHTTP:               isHTTP,
@@ -247,6 +261,12 @@ func initClient(conn ServerCodec, services *serviceRegistry, cfg *clientConfig)
 		idgen:                cfg.idgen,
 		batchItemLimit:       cfg.batchItemLimit,
 		batchResponseMaxSize: cfg.batchResponseLimit,
+		rateLimiter:          cfg.rateLimiter,
+		accessControl:        cfg.accessControl,
+		recorder:             cfg.recorder,
+		reconnectFunc:        connect,
+		autoReconnect:        cfg.autoReconnect && connect != nil,
+		suspend:              make(chan *ClientSubscription),
 		writeConn:            conn,
 		close:                make(chan struct{}),
 		closing:              make(chan struct{}),
@@ -499,6 +519,18 @@ func (c *Client) EthSubscribe(ctx context.Context, channel interface{}, args ...
 // ErrSubscriptionQueueOverflow. Use a sufficiently large buffer on the channel or ensure
 // that the channel usually has at least one reader to prevent this issue.
 func (c *Client) Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*ClientSubscription, error) {
+	return c.subscribe(ctx, namespace, nil, channel, args...)
+}
+
+// SubscribeResumable registers a subscription just like Subscribe. If the client
+// was created with WithAutoReconnect, the resumer is used to retrieve the
+// notifications missed while the connection was down and to filter out the ones
+// delivered twice, when the subscription is re-established after a reconnect.
+func (c *Client) SubscribeResumable(ctx context.Context, namespace string, resumer SubscriptionResumer, channel interface{}, args ...interface{}) (*ClientSubscription, error) {
+	return c.subscribe(ctx, namespace, resumer, channel, args...)
+}
+
+func (c *Client) subscribe(ctx context.Context, namespace string, resumer SubscriptionResumer, channel interface{}, args ...interface{}) (*ClientSubscription, error) {
 	// Check type of channel first.
 	chanVal := reflect.ValueOf(channel)
 	if chanVal.Kind() != reflect.Chan || chanVal.Type().ChanDir()&reflect.SendDir == 0 {
@@ -518,7 +550,13 @@ func (c *Client) Subscribe(ctx context.Context, namespace string, channel interf
 	op := &requestOp{
 		ids:  []json.RawMessage{msg.ID},
 		resp: make(chan []*jsonrpcMessage, 1),
-		sub:  newClientSubscription(c, namespace, chanVal),
+		sub:  newClientSubscription(c, namespace, chanVal, args),
+	}
+	if c.autoReconnect && resumer != nil {
+		if err := resumer.Init(ctx, c); err != nil {
+			return nil, err
+		}
+		op.sub.resumer = resumer
 	}
 
 	// Send the subscription request.
@@ -618,6 +656,9 @@ func (c *Client) dispatch(codec ServerCodec) {
 		reqInitLock = c.reqInit // nil while the send lock is held
 		conn        = c.newClientConn(codec)
 		reading     = true
+
+		// Subscriptions waiting for a new connection, if auto reconnecting
+		suspended = make(map[*ClientSubscription]struct{})
 	)
 	defer func() {
 		close(c.closing)
@@ -625,8 +666,24 @@ func (c *Client) dispatch(codec ServerCodec) {
 			conn.close(ErrClientQuit, nil)
 			c.drainRead()
 		}
+		for sub := range suspended {
+			sub.close(ErrClientQuit)
+		}
 		close(c.didClose)
 	}()
+	suspendAll := func() {
+		if c.autoReconnect {
+			for _, sub := range conn.handler.takeClientSubscriptions() {
+				suspended[sub] = struct{}{}
+			}
+		}
+	}
+	resubscribe := func() {
+		if len(suspended) > 0 {
+			go c.resubscribe(slices.Collect(maps.Keys(suspended)))
+			clear(suspended)
+		}
+	}
 
 	// Spawn the initial read loop.
 	go c.read(codec)
@@ -646,8 +703,12 @@ func (c *Client) dispatch(codec ServerCodec) {
 
 		case err := <-c.readErr:
 			conn.handler.log.Debug("RPC connection read error", "err", err)
+			suspendAll()
 			conn.close(err, lastOp)
 			reading = false
+			if c.autoReconnect {
+				go c.redial(conn.codec)
+			}
 
 		// Reconnect:
 		case newcodec := <-c.reconnected:
@@ -658,6 +719,7 @@ func (c *Client) dispatch(codec ServerCodec) {
 				// In those cases the caller will notice first and reconnect. Closing the
 				// handler terminates all waiting requests (closing op.resp) except for
 				// lastOp, which will be transferred to the new handler.
+				suspendAll()
 				conn.close(errClientReconnected, lastOp)
 				c.drainRead()
 			}
@@ -667,6 +729,16 @@ func (c *Client) dispatch(codec ServerCodec) {
 			// Re-register the in-flight request on the new handler
 			// because that's where it will be sent.
 			conn.handler.addRequestOp(lastOp)
+			resubscribe()
+
+		case sub := <-c.suspend:
+			// Resubscribing failed, retry on the next connection. If the current
+			// one is still alive, retry right away.
+			conn.handler.removeClientSubscription(sub)
+			suspended[sub] = struct{}{}
+			if reading {
+				resubscribe()
+			}
 
 		// Send path:
 		case op := <-reqInitLock:
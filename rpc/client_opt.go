@@ -41,6 +41,12 @@ type clientConfig struct {
 	idgen              func() ID
 	batchItemLimit     int
 	batchResponseLimit int
+	rateLimiter        *RateLimiter
+	accessControl      *AccessControl
+	recorder           *Recorder
+
+	// Connection recovery
+	autoReconnect bool
 }
 
 func (cfg *clientConfig) initHeaders() {
@@ -142,3 +148,17 @@ func WithBatchResponseSizeLimit(sizeLimit int) ClientOption {
 		cfg.batchResponseLimit = sizeLimit
 	})
 }
+
+// WithAutoReconnect makes the client redial the server with exponential backoff as
+// soon as a websocket or IPC connection is lost, instead of waiting for the next
+// call. Active subscriptions are re-established on the new connection rather than
+// failing. Subscriptions created with SubscribeResumable additionally receive the
+// notifications missed while the connection was down.
+//
+// The option has no effect on clients which can't redial the server, like the
+// ones passed to handlers for reverse calls.
+func WithAutoReconnect() ClientOption {
+	return optionFunc(func(cfg *clientConfig) {
+		cfg.autoReconnect = true
+	})
+}
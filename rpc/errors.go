@@ -61,6 +61,7 @@ const (
 	errcodeDefault          = -32000
 	errcodeTimeout          = -32002
 	errcodeResponseTooLarge = -32003
+	errcodeRateLimited      = -32005
 	errcodePanic            = -32603
 	errcodeMarshalError     = -32603
 
@@ -73,6 +74,24 @@ const (
 	errMsgBatchTooLarge    = "batch too large"
 )
 
+type rateLimitError struct{ group string }
+
+func (e *rateLimitError) ErrorCode() int { return errcodeRateLimited }
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for %s requests", e.group)
+}
+
+// accessDeniedError is returned for methods the client may not access. It uses
+// the code of unavailable methods, as the method is unavailable to the client.
+type accessDeniedError struct{ method string }
+
+func (e *accessDeniedError) ErrorCode() int { return -32601 }
+
+func (e *accessDeniedError) Error() string {
+	return fmt.Sprintf("access to method %s denied", e.method)
+}
+
 type methodNotFoundError struct{ method string }
 
 func (e *methodNotFoundError) ErrorCode() int { return -32601 }
@@ -68,6 +68,9 @@ type handler struct {
 	allowSubscribe       bool
 	batchRequestLimit    int
 	batchResponseMaxSize int
+	rateLimiter          *RateLimiter
+	accessControl        *AccessControl
+	recorder             *Recorder
 	tracerProvider       trace.TracerProvider
 
 	subLock    sync.Mutex
@@ -234,7 +237,8 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 			if msg == nil {
 				break
 			}
-			resp := h.handleCallMsg(cp, msg)
+			// Streamed results are encoded here to account for the response size.
+			resp := h.handleCallMsg(cp, msg).materialize()
 			callBuffer.pushResponse(resp)
 			if resp != nil && h.batchResponseMaxSize != 0 {
 				responseBytes += len(resp.Result)
@@ -285,26 +289,29 @@ func (h *handler) handleNonBatchCall(cp *callProc, msg *jsonrpcMessage) {
 	var (
 		responded sync.Once
 		timer     *time.Timer
-		cancel    context.CancelFunc
+		cancel    context.CancelCauseFunc
 	)
-	cp.ctx, cancel = context.WithCancel(cp.ctx)
-	defer cancel()
+	cp.ctx, cancel = context.WithCancelCause(cp.ctx)
+	defer cancel(nil)
 
 	// Cancel the request context after timeout and send an error response. Since the
 	// running method might not return immediately on timeout, we must wait for the
 	// timeout concurrently with processing the request.
 	if timeout, ok := ContextRequestTimeout(cp.ctx); ok {
 		timer = time.AfterFunc(timeout, func() {
-			cancel()
+			err := &internalServerError{errcodeTimeout, errMsgTimeout}
+			cancel(err)
 			responded.Do(func() {
-				resp := msg.errorResponse(&internalServerError{errcodeTimeout, errMsgTimeout})
-				h.conn.writeJSON(cp.ctx, resp, true)
+				h.conn.writeJSON(cp.ctx, msg.errorResponse(err), true)
 			})
 		})
 	}
 
 	answer := h.handleCallMsg(cp, msg)
-	if timer != nil {
+	// Streamed results are produced while the response is written, so the timeout
+	// stays armed until then. A stream aborted by the timeout before any output was
+	// sent is answered with the timeout error by the codec.
+	if timer != nil && (answer == nil || answer.stream == nil) {
 		timer.Stop()
 	}
 	h.addSubscriptions(cp.notifiers)
@@ -313,6 +320,9 @@ func (h *handler) handleNonBatchCall(cp *callProc, msg *jsonrpcMessage) {
 			h.conn.writeJSON(cp.ctx, answer, false)
 		})
 	}
+	if timer != nil {
+		timer.Stop()
+	}
 	for _, n := range cp.notifiers {
 		n.activate()
 	}
@@ -341,6 +351,26 @@ func (h *handler) removeRequestOp(op *requestOp) {
 	}
 }
 
+// takeClientSubscriptions removes and returns the active client subscriptions,
+// such that they survive closing the handler.
+func (h *handler) takeClientSubscriptions() []*ClientSubscription {
+	subs := make([]*ClientSubscription, 0, len(h.clientSubs))
+	for id, sub := range h.clientSubs {
+		delete(h.clientSubs, id)
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// removeClientSubscription stops delivering notifications to a subscription.
+func (h *handler) removeClientSubscription(sub *ClientSubscription) {
+	for id, s := range h.clientSubs {
+		if s == sub {
+			delete(h.clientSubs, id)
+		}
+	}
+}
+
 // cancelAllRequests unblocks and removes pending requests and active subscriptions.
 func (h *handler) cancelAllRequests(err error, inflightReq *requestOp) {
 	didClose := make(map[*requestOp]bool)
@@ -417,10 +447,15 @@ func (h *handler) handleResponses(batch []*jsonrpcMessage, handleCall func(*json
 			if msg.Error != nil {
 				op.err = msg.Error
 			} else {
-				op.err = json.Unmarshal(msg.Result, &op.sub.subid)
+				var subid string
+				op.err = json.Unmarshal(msg.Result, &subid)
 				if op.err == nil {
-					go op.sub.run()
-					h.clientSubs[op.sub.subid] = op.sub
+					// Resubscribed subscriptions keep their forwarding loop.
+					op.sub.setID(subid)
+					if !op.resume {
+						go op.sub.run()
+					}
+					h.clientSubs[subid] = op.sub
 				}
 			}
 		}
@@ -477,7 +512,17 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 		return nil
 
 	case msg.isCall():
+		var record *RecordedCall
+		if h.recorder != nil {
+			record = h.recorder.begin(ctx.ctx, msg)
+		}
 		resp := h.handleCall(ctx, msg)
+		if record != nil {
+			// Streams can only be encoded once, encode the result for both the
+			// recording and the client.
+			resp = resp.materialize()
+			h.recorder.finish(record, resp)
+		}
 		var logctx []any
 		logctx = append(logctx, "reqid", idForLog{msg.ID}, "duration", time.Since(start))
 		if resp.Error != nil {
@@ -501,9 +546,6 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 
 // handleCall processes method calls.
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
-	if msg.isSubscribe() {
-		return h.handleSubscribe(cp, msg)
-	}
 	if msg.isUnsubscribe() {
 		args, err := parsePositionalArguments(msg.Params, h.unsubscribeCb.argTypes)
 		if err != nil {
@@ -511,6 +553,21 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 		}
 		return h.runMethod(cp.ctx, msg, h.unsubscribeCb, args)
 	}
+	// Enforce the access rules and the quota of the client. Unsubscribing is
+	// always allowed, as it frees up server resources.
+	if h.accessControl != nil {
+		if err := h.accessControl.check(cp.ctx, msg.Method); err != nil {
+			return msg.errorResponse(err)
+		}
+	}
+	if h.rateLimiter != nil {
+		if err := h.rateLimiter.allow(cp.ctx, msg.Method); err != nil {
+			return msg.errorResponse(err)
+		}
+	}
+	if msg.isSubscribe() {
+		return h.handleSubscribe(cp, msg)
+	}
 
 	// Check method name length
 	if len(msg.Method) > maxMethodNameLength {
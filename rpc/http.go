@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -302,7 +303,11 @@ func (s *Server) newHTTPServerConn(r *http.Request, w http.ResponseWriter) Serve
 	dec := json.NewDecoder(conn)
 	dec.UseNumber()
 
-	return NewFuncCodec(conn, encoder, dec.Decode)
+	codec := NewFuncCodec(conn, encoder, dec.Decode).(*jsonCodec)
+	codec.streamWriter = func() (io.WriteCloser, error) {
+		return nopWriteCloser{w}, nil
+	}
+	return codec
 }
 
 // Close does nothing and always returns nil.
@@ -328,25 +333,71 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create request-scoped context.
+	// All checks passed, create a codec that reads directly from the request body
+	// until EOF, writes the response to w, and orders the server to process a
+	// single request.
+	w.Header().Set("content-type", contentType)
+	codec := s.newHTTPServerConn(r, w)
+	defer codec.close()
+	s.serveSingleRequest(httpRequestContext(r), codec)
+}
+
+// CallHTTP invokes a method of the registered services in-process on behalf of the
+// client of an HTTP request, returning the result of the method as is instead of
+// encoding it. The access rules and rate limits of the server apply just like for
+// JSON-RPC requests of the client. Subscriptions are not supported.
+//
+// Results which are streams are produced while being read, this must happen
+// before the HTTP request is done.
+func (s *Server) CallHTTP(r *http.Request, method string, params []json.RawMessage) (interface{}, error) {
+	if !s.run.Load() {
+		return nil, errors.New("server is stopped")
+	}
+	if strings.HasSuffix(method, subscribeMethodSuffix) || strings.HasSuffix(method, unsubscribeMethodSuffix) {
+		return nil, ErrNotificationsUnsupported
+	}
+	ctx := httpRequestContext(r)
+	if s.accessControl != nil {
+		if err := s.accessControl.check(ctx, method); err != nil {
+			return nil, err
+		}
+	}
+	if s.rateLimiter != nil {
+		if err := s.rateLimiter.allow(ctx, method); err != nil {
+			return nil, err
+		}
+	}
+	callb, _, _ := s.services.callback(method)
+	if callb == nil {
+		return nil, &methodNotFoundError{method: method}
+	}
+	if params == nil {
+		params = []json.RawMessage{}
+	}
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, &invalidParamsError{err.Error()}
+	}
+	args, err := parsePositionalArguments(rawParams, callb.argTypes)
+	if err != nil {
+		return nil, &invalidParamsError{err.Error()}
+	}
+	return callb.call(ctx, method, args)
+}
+
+// httpRequestContext creates the context of calls served for an HTTP request.
+func httpRequestContext(r *http.Request) context.Context {
 	connInfo := PeerInfo{Transport: "http", RemoteAddr: r.RemoteAddr}
 	connInfo.HTTP.Version = r.Proto
 	connInfo.HTTP.Host = r.Host
 	connInfo.HTTP.Origin = r.Header.Get("Origin")
 	connInfo.HTTP.UserAgent = r.Header.Get("User-Agent")
-	ctx := r.Context()
-	ctx = context.WithValue(ctx, peerInfoContextKey{}, connInfo)
+	connInfo.Subject = subjectFromContext(r.Context())
+	connInfo.ClientCertSubject = clientCertSubject(r)
+	ctx := context.WithValue(r.Context(), peerInfoContextKey{}, connInfo)
 
 	// Extract trace context from incoming headers.
-	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
-
-	// All checks passed, create a codec that reads directly from the request body
-	// until EOF, writes the response to w, and orders the server to process a
-	// single request.
-	w.Header().Set("content-type", contentType)
-	codec := s.newHTTPServerConn(r, w)
-	defer codec.close()
-	s.serveSingleRequest(ctx, codec)
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
 }
 
 // validateRequest returns a non-zero response code and error message if the
@@ -407,3 +458,12 @@ func ContextRequestTimeout(ctx context.Context) (time.Duration, bool) {
 
 	return timeout, hasTimeout
 }
+
+// clientCertSubject returns the subject of the verified TLS client certificate of
+// a request.
+func clientCertSubject(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return r.TLS.VerifiedChains[0][0].Subject.String()
+}
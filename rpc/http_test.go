@@ -18,6 +18,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -263,3 +264,51 @@ func TestNewContextWithHeaders(t *testing.T) {
 		t.Error("call failed:", err)
 	}
 }
+
+func TestServerCallHTTP(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer()
+	defer server.Stop()
+
+	ac, err := NewAccessControl([]AccessRule{{Deny: []string{"test_noArgsRets"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetAccessControl(ac)
+	req := httptest.NewRequest(http.MethodPost, "http://localhost/", nil)
+
+	// Results are returned without encoding them.
+	result, err := server.CallHTTP(req, "test_echo", []json.RawMessage{[]byte(`"x"`), []byte(`1`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if echo, ok := result.(echoResult); !ok || echo.String != "x" || echo.Int != 1 {
+		t.Fatalf("wrong result: %#v", result)
+	}
+	// The peer info of the request is available to the method.
+	result, err = server.CallHTTP(req, "test_peerInfo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info := result.(PeerInfo); info.Transport != "http" || info.RemoteAddr != req.RemoteAddr {
+		t.Fatalf("wrong peer info: %+v", info)
+	}
+	// Errors match the ones of JSON-RPC requests.
+	tests := []struct {
+		method string
+		params []json.RawMessage
+		code   int
+	}{
+		{"test_noArgsRets", nil, -32601},
+		{"test_unknown", nil, -32601},
+		{"test_echo", []json.RawMessage{[]byte(`1`)}, -32602},
+		{"nftest_subscribe", []json.RawMessage{[]byte(`"someSubscription"`)}, -32601},
+	}
+	for _, test := range tests {
+		_, err := server.CallHTTP(req, test.method, test.params)
+		if rpcErr, ok := err.(Error); !ok || rpcErr.ErrorCode() != test.code {
+			t.Errorf("%s: expected error code %d, got %v", test.method, test.code, err)
+		}
+	}
+}
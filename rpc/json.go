@@ -67,6 +67,8 @@ type jsonrpcMessage struct {
 	Params  json.RawMessage `json:"params,omitempty"`
 	Error   *jsonError      `json:"error,omitempty"`
 	Result  json.RawMessage `json:"result,omitempty"`
+
+	stream Stream // result encoded while writing the response, instead of Result
 }
 
 func (msg *jsonrpcMessage) isNotification() bool {
@@ -114,6 +116,9 @@ func (msg *jsonrpcMessage) errorResponse(err error) *jsonrpcMessage {
 }
 
 func (msg *jsonrpcMessage) response(result interface{}) *jsonrpcMessage {
+	if s, ok := result.(Stream); ok {
+		return &jsonrpcMessage{Version: vsn, ID: msg.ID, stream: s}
+	}
 	enc, err := json.Marshal(result)
 	if err != nil {
 		return msg.errorResponse(&internalServerError{errcodeMarshalError, err.Error()})
@@ -186,6 +191,11 @@ type jsonCodec struct {
 	encMu   sync.Mutex       // guards the encoder
 	encode  encodeFunc       // encoder to allow multiple transports
 	conn    deadlineCloser
+
+	// streamWriter opens a writer for a single outgoing message, allowing streamed
+	// results to be written incrementally. Streams are encoded into memory first
+	// if it is nil.
+	streamWriter func() (io.WriteCloser, error)
 }
 
 type encodeFunc = func(v interface{}, isErrorResponse bool) error
@@ -218,7 +228,11 @@ func NewCodec(conn Conn) ServerCodec {
 	encode := func(v interface{}, isErrorResponse bool) error {
 		return enc.Encode(v)
 	}
-	return NewFuncCodec(conn, encode, dec.Decode)
+	codec := NewFuncCodec(conn, encode, dec.Decode).(*jsonCodec)
+	codec.streamWriter = func() (io.WriteCloser, error) {
+		return nopWriteCloser{conn}, nil
+	}
+	return codec
 }
 
 func (c *jsonCodec) peerInfo() PeerInfo {
@@ -249,6 +263,12 @@ func (c *jsonCodec) readBatch() (messages []*jsonrpcMessage, batch bool, err err
 }
 
 func (c *jsonCodec) writeJSON(ctx context.Context, v interface{}, isErrorResponse bool) error {
+	if msg, ok := v.(*jsonrpcMessage); ok && msg.stream != nil {
+		if c.streamWriter != nil {
+			return c.writeStream(ctx, msg)
+		}
+		v = msg.materialize()
+	}
 	c.encMu.Lock()
 	defer c.encMu.Unlock()
 
@@ -32,6 +32,13 @@ var (
 	serveTimeHistName = "rpc/duration"
 
 	rpcServingTimer = metrics.NewRegisteredTimer("rpc/duration/all", nil)
+
+	// rateLimitRejectedName is the prefix of the per-group rejection meters.
+	rateLimitRejectedName = "rpc/ratelimit/rejected"
+
+	rateLimitRejectedMeter = metrics.NewRegisteredMeter("rpc/ratelimit/rejected/all", nil)
+
+	accessDeniedMeter = metrics.NewRegisteredMeter("rpc/access/denied", nil)
 )
 
 // updateServeTimeHistogram tracks the serving time of a remote RPC call.
@@ -0,0 +1,176 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitClientCacheSize is the number of clients tracked by every rate limit
+// group. Buckets of the least recently seen clients are dropped beyond this.
+const rateLimitClientCacheSize = 16384
+
+// RateLimitGroup configures a token-bucket limit applied to a group of methods.
+// Every client has its own bucket per group, clients are identified by the
+// authenticated subject if available, or by their IP address otherwise.
+type RateLimitGroup struct {
+	// Name identifies the group in error messages and metrics.
+	Name string
+
+	// Methods matched by the group. A method ending in '*' matches all methods
+	// starting with the given prefix, a lone '*' matches any method.
+	Methods []string
+
+	// Rate is the number of requests per second a client may issue.
+	Rate float64
+
+	// Burst is the maximum number of requests a client may issue at once. If
+	// zero, it defaults to the rate rounded up.
+	Burst int `toml:",omitempty"`
+}
+
+// RateLimiter throttles the requests of clients according to the configured
+// method groups. A single limiter may be shared by multiple servers, enforcing
+// a common quota across them.
+type RateLimiter struct {
+	groups []*rateLimitGroup
+}
+
+type rateLimitGroup struct {
+	name     string
+	exact    map[string]struct{}
+	prefixes []string
+	limit    rate.Limit
+	burst    int
+	rejected *metrics.Meter
+
+	mu      sync.Mutex
+	clients lru.BasicLRU[string, *rate.Limiter]
+}
+
+// NewRateLimiter creates a rate limiter for the given groups. Methods are matched
+// against the groups in order, the first matching group applies. Methods not
+// matching any group are not limited.
+func NewRateLimiter(groups []RateLimitGroup) (*RateLimiter, error) {
+	l := new(RateLimiter)
+	names := make(map[string]struct{})
+	for _, cfg := range groups {
+		if cfg.Name == "" {
+			return nil, errors.New("rate limit group without name")
+		}
+		if _, ok := names[cfg.Name]; ok {
+			return nil, fmt.Errorf("duplicate rate limit group %q", cfg.Name)
+		}
+		names[cfg.Name] = struct{}{}
+		if len(cfg.Methods) == 0 {
+			return nil, fmt.Errorf("rate limit group %q has no methods", cfg.Name)
+		}
+		if cfg.Rate <= 0 || math.IsInf(cfg.Rate, 0) || math.IsNaN(cfg.Rate) {
+			return nil, fmt.Errorf("invalid rate %v in rate limit group %q", cfg.Rate, cfg.Name)
+		}
+		if cfg.Burst < 0 {
+			return nil, fmt.Errorf("invalid burst %d in rate limit group %q", cfg.Burst, cfg.Name)
+		}
+		g := &rateLimitGroup{
+			name:     cfg.Name,
+			exact:    make(map[string]struct{}),
+			limit:    rate.Limit(cfg.Rate),
+			burst:    cfg.Burst,
+			clients:  lru.NewBasicLRU[string, *rate.Limiter](rateLimitClientCacheSize),
+			rejected: metrics.GetOrRegisterMeter(fmt.Sprintf("%s/%s", rateLimitRejectedName, cfg.Name), nil),
+		}
+		if g.burst == 0 {
+			g.burst = int(math.Ceil(cfg.Rate))
+		}
+		for _, method := range cfg.Methods {
+			if prefix, ok := strings.CutSuffix(method, "*"); ok {
+				g.prefixes = append(g.prefixes, prefix)
+			} else {
+				g.exact[method] = struct{}{}
+			}
+		}
+		l.groups = append(l.groups, g)
+	}
+	return l, nil
+}
+
+// allow consumes a token from the bucket of the calling client for the group
+// matching the method. It returns an error if the client exceeded its quota.
+func (l *RateLimiter) allow(ctx context.Context, method string) error {
+	g := l.group(method)
+	if g == nil {
+		return nil
+	}
+	if !g.limiter(rateLimitClientID(PeerInfoFromContext(ctx))).Allow() {
+		rateLimitRejectedMeter.Mark(1)
+		g.rejected.Mark(1)
+		return &rateLimitError{group: g.name}
+	}
+	return nil
+}
+
+// limiter returns the token bucket of a client, creating it if needed.
+func (g *rateLimitGroup) limiter(client string) *rate.Limiter {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	limiter, ok := g.clients.Get(client)
+	if !ok {
+		limiter = rate.NewLimiter(g.limit, g.burst)
+		g.clients.Add(client, limiter)
+	}
+	return limiter
+}
+
+// group returns the first group matching the method, or nil.
+func (l *RateLimiter) group(method string) *rateLimitGroup {
+	for _, g := range l.groups {
+		if _, ok := g.exact[method]; ok {
+			return g
+		}
+		for _, prefix := range g.prefixes {
+			if strings.HasPrefix(method, prefix) {
+				return g
+			}
+		}
+	}
+	return nil
+}
+
+// rateLimitClientID returns the identity the quota of a client is tracked by.
+// Authenticated clients are identified by their subject, all connections from
+// the same IP address share the quota otherwise.
+func rateLimitClientID(info PeerInfo) string {
+	if info.Subject != "" {
+		return "sub:" + info.Subject
+	}
+	host, _, err := net.SplitHostPort(info.RemoteAddr)
+	if err != nil {
+		host = info.RemoteAddr
+	}
+	return "ip:" + host
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRateLimiterConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []RateLimitGroup{
+		{Methods: []string{"*"}, Rate: 1},
+		{Name: "all", Rate: 1},
+		{Name: "all", Methods: []string{"*"}},
+		{Name: "all", Methods: []string{"*"}, Rate: 1, Burst: -1},
+	}
+	for i, group := range tests {
+		if _, err := NewRateLimiter([]RateLimitGroup{group}); err == nil {
+			t.Errorf("test %d: expected error for invalid group %+v", i, group)
+		}
+	}
+	dup := []RateLimitGroup{
+		{Name: "a", Methods: []string{"*"}, Rate: 1},
+		{Name: "a", Methods: []string{"*"}, Rate: 1},
+	}
+	if _, err := NewRateLimiter(dup); err == nil {
+		t.Error("expected error for duplicate group names")
+	}
+}
+
+func TestRateLimiterGroups(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := NewRateLimiter([]RateLimitGroup{
+		{Name: "trace", Methods: []string{"debug_trace*", "eth_getLogs"}, Rate: 1},
+		{Name: "default", Methods: []string{"eth_*"}, Rate: 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"debug_traceTransaction": "trace",
+		"debug_traceCall":        "trace",
+		"eth_getLogs":            "trace",
+		"eth_getLogsX":           "default",
+		"eth_blockNumber":        "default",
+		"debug_getRawBlock":      "",
+	}
+	for method, want := range tests {
+		var have string
+		if g := limiter.group(method); g != nil {
+			have = g.name
+		}
+		if have != want {
+			t.Errorf("method %s: group mismatch, have %q want %q", method, have, want)
+		}
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer()
+	defer server.Stop()
+
+	limiter, err := NewRateLimiter([]RateLimitGroup{
+		{Name: "echo", Methods: []string{"test_echo"}, Rate: 0.001, Burst: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetRateLimiter(limiter)
+
+	// Authenticate clients by a header to check quotas are tracked per subject.
+	httpsrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subject := r.Header.Get("X-Subject"); subject != "" {
+			r = r.WithContext(ContextWithSubject(r.Context(), subject))
+		}
+		server.ServeHTTP(w, r)
+	}))
+	defer httpsrv.Close()
+
+	dial := func(subject string) *Client {
+		client, err := DialOptions(context.Background(), httpsrv.URL, WithHeader("X-Subject", subject))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+	alice, bob := dial("alice"), dial("bob")
+	defer alice.Close()
+	defer bob.Close()
+
+	var result echoResult
+	for i := 0; i < 2; i++ {
+		if err := alice.Call(&result, "test_echo", "x", 1); err != nil {
+			t.Fatalf("call %d failed: %v", i, err)
+		}
+	}
+	err = alice.Call(&result, "test_echo", "x", 1)
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != errcodeRateLimited {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+	// Other methods and other clients are unaffected.
+	if err := alice.Call(nil, "test_noArgsRets"); err != nil {
+		t.Fatalf("unlimited method failed: %v", err)
+	}
+	if err := bob.Call(&result, "test_echo", "x", 1); err != nil {
+		t.Fatalf("call of other client failed: %v", err)
+	}
+	// The subject is reported to method handlers.
+	var info PeerInfo
+	if err := bob.Call(&info, "test_peerInfo"); err != nil {
+		t.Fatal(err)
+	}
+	if info.Subject != "bob" {
+		t.Fatalf("wrong subject in peer info: %q", info.Subject)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// RecordedCall is a method call written by a Recorder, along with the response
+// of the server.
+type RecordedCall struct {
+	Time      time.Time       `json:"time"`                // Time the call was received
+	Duration  time.Duration   `json:"duration"`            // Time taken to serve the call, in nanoseconds
+	Head      *uint64         `json:"head,omitempty"`      // Head block of the node when the call was received
+	Transport string          `json:"transport,omitempty"` // Transport the call was received on
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     json.RawMessage `json:"error,omitempty"`
+}
+
+// Recorder writes the method calls served by a server to a log, one JSON encoded
+// RecordedCall per line. Recordings can be replayed against another node to find
+// differences in the responses.
+//
+// Streamed results are encoded into memory before being written to the client
+// while recording is enabled. Notifications and subscription events are not
+// recorded.
+type Recorder struct {
+	head atomic.Pointer[func() uint64]
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewRecorder creates a recorder writing to the given output. Closing the output
+// is the responsibility of the caller.
+func NewRecorder(out io.Writer) *Recorder {
+	return &Recorder{out: out}
+}
+
+// SetHeadFunc sets the function reporting the current head block, which is
+// recorded along with every call.
+func (r *Recorder) SetHeadFunc(head func() uint64) {
+	r.head.Store(&head)
+}
+
+// begin starts the record of a call, capturing the head block at the time the
+// call was received.
+func (r *Recorder) begin(ctx context.Context, msg *jsonrpcMessage) *RecordedCall {
+	call := &RecordedCall{
+		Time:      time.Now(),
+		Transport: PeerInfoFromContext(ctx).Transport,
+		Method:    msg.Method,
+		Params:    msg.Params,
+	}
+	if head := r.head.Load(); head != nil {
+		number := (*head)()
+		call.Head = &number
+	}
+	return call
+}
+
+// finish completes the record of a call with the response and writes it out.
+func (r *Recorder) finish(call *RecordedCall, resp *jsonrpcMessage) {
+	call.Duration = time.Since(call.Time)
+	if resp != nil {
+		call.Result = resp.Result
+		if resp.Error != nil {
+			call.Error, _ = json.Marshal(resp.Error)
+		}
+	}
+	line, err := json.Marshal(call)
+	if err != nil {
+		log.Warn("Failed to encode recorded RPC call", "method", call.Method, "err", err)
+		return
+	}
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.out.Write(line); err != nil {
+		log.Warn("Failed to write recorded RPC call", "method", call.Method, "err", err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// syncBuffer is a buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) calls(t *testing.T) []RecordedCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var calls []RecordedCall
+	scanner := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for scanner.Scan() {
+		var call RecordedCall
+		if err := json.Unmarshal(scanner.Bytes(), &call); err != nil {
+			t.Fatalf("invalid recorded call %q: %v", scanner.Text(), err)
+		}
+		calls = append(calls, call)
+	}
+	return calls
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		out      = new(syncBuffer)
+		recorder = NewRecorder(out)
+		server   = newTestServer()
+	)
+	recorder.SetHeadFunc(func() uint64 { return 42 })
+	server.SetRecorder(recorder)
+	defer server.Stop()
+
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+
+	client, err := DialHTTP(httpsrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	var (
+		echo   echoResult
+		stream []int
+	)
+	if err := client.Call(&echo, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Call(nil, "test_returnError"); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := client.Call(&stream, "test_stream", 3, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream) != 3 {
+		t.Fatalf("wrong stream result: %v", stream)
+	}
+	batch := []BatchElem{
+		{Method: "test_repeat", Args: []any{"x", 2}, Result: new(string)},
+		{Method: "test_null", Result: new(any)},
+	}
+	if err := client.BatchCallContext(context.Background(), batch); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := out.calls(t)
+	if len(calls) != 5 {
+		t.Fatalf("wrong number of recorded calls: %d", len(calls))
+	}
+	want := []struct {
+		method, params, result string
+		failed                 bool
+	}{
+		{"test_echo", `["hello",10,{"S":"world"}]`, `{"String":"hello","Int":10,"Args":{"S":"world"}}`, false},
+		{"test_returnError", ``, ``, true},
+		{"test_stream", `[3,null]`, `[0,1,2]`, false},
+		{"test_repeat", `["x",2]`, `"xx"`, false},
+		{"test_null", ``, `null`, false},
+	}
+	for i, call := range calls {
+		if call.Method != want[i].method {
+			t.Errorf("call %d: wrong method %q, want %q", i, call.Method, want[i].method)
+		}
+		if string(call.Params) != want[i].params {
+			t.Errorf("call %d: wrong params %s, want %s", i, call.Params, want[i].params)
+		}
+		if string(call.Result) != want[i].result {
+			t.Errorf("call %d: wrong result %s, want %s", i, call.Result, want[i].result)
+		}
+		if (call.Error != nil) != want[i].failed {
+			t.Errorf("call %d: wrong error %s", i, call.Error)
+		}
+		if call.Head == nil || *call.Head != 42 {
+			t.Errorf("call %d: wrong head %v", i, call.Head)
+		}
+		if call.Transport != "http" {
+			t.Errorf("call %d: wrong transport %q", i, call.Transport)
+		}
+	}
+}
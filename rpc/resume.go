@@ -0,0 +1,162 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// SubscriptionResumer bridges the gap in the notifications of a subscription that
+// is re-established after the client reconnected. Its methods are never called
+// concurrently.
+type SubscriptionResumer interface {
+	// Init is called before the subscription is created, allowing the resumer to
+	// record where the notifications start.
+	Init(ctx context.Context, c *Client) error
+
+	// Filter is called with every notification before it is delivered to the
+	// subscriber. Returning false drops the notification as a duplicate.
+	Filter(result json.RawMessage) bool
+
+	// Resume is called after the subscription was re-established on a new
+	// connection. It returns the notifications missed while the connection was
+	// down, which are delivered ahead of the ones of the new subscription.
+	Resume(ctx context.Context, c *Client) ([]json.RawMessage, error)
+}
+
+// redial reconnects the client with exponential backoff after the given
+// connection was lost, until it succeeds or the client is closed. It's only used
+// by auto reconnecting clients, which always have a connect function.
+func (c *Client) redial(dead ServerCodec) {
+	backoff := minReconnectBackoff
+	for {
+		err := c.redialOnce(dead)
+		if err == nil || errors.Is(err, ErrClientQuit) {
+			return
+		}
+		log.Debug("RPC client redial failed", "backoff", backoff, "err", err)
+		select {
+		case <-time.After(backoff):
+		case <-c.closing:
+			return
+		}
+		backoff = min(2*backoff, maxReconnectBackoff)
+	}
+}
+
+// redialOnce establishes a new connection unless a call did so already since the
+// given connection was lost.
+func (c *Client) redialOnce(dead ServerCodec) error {
+	// Take the write lock, writeConn may only be replaced while holding it.
+	op := new(requestOp)
+	select {
+	case c.reqInit <- op:
+	case <-c.closing:
+		return ErrClientQuit
+	}
+	if c.writeConn == dead {
+		c.writeConn = nil
+	}
+	var err error
+	if c.writeConn == nil {
+		err = c.reconnect(context.Background())
+	}
+	c.reqSent <- err
+	return err
+}
+
+// resubscribe re-establishes the given subscriptions on the current connection.
+// Subscriptions rejected by the server are closed with the error, the others are
+// suspended again until the next connection.
+func (c *Client) resubscribe(subs []*ClientSubscription) {
+	for _, sub := range subs {
+		err := c.resubscribeOne(sub)
+		if err == nil {
+			continue
+		}
+		var rpcErr Error
+		switch {
+		case errors.Is(err, ErrClientQuit):
+			sub.close(ErrClientQuit)
+		case errors.As(err, &rpcErr):
+			log.Debug("RPC client resubscribe rejected", "namespace", sub.namespace, "err", err)
+			sub.close(err)
+		default:
+			log.Debug("RPC client resubscribe failed", "namespace", sub.namespace, "err", err)
+			select {
+			case <-time.After(minReconnectBackoff):
+			case <-c.closing:
+				sub.close(ErrClientQuit)
+				continue
+			}
+			select {
+			case c.suspend <- sub:
+			case <-c.closing:
+				sub.close(ErrClientQuit)
+			}
+		}
+	}
+}
+
+// resubscribeOne re-establishes a single subscription, holding back its new
+// notifications until the missed ones were delivered. On failure, notifications
+// stay held back until a retry succeeds.
+func (c *Client) resubscribeOne(sub *ClientSubscription) error {
+	sub.resumeLock.Lock()
+	defer sub.resumeLock.Unlock()
+
+	select {
+	case sub.hold <- struct{}{}:
+	case <-sub.forwardDone:
+		return nil // unsubscribed meanwhile
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancel()
+
+	msg, err := c.newMessage(sub.namespace+subscribeMethodSuffix, sub.args...)
+	if err != nil {
+		return err
+	}
+	op := &requestOp{
+		ids:    []json.RawMessage{msg.ID},
+		resp:   make(chan []*jsonrpcMessage, 1),
+		sub:    sub,
+		resume: true,
+	}
+	if err := c.send(ctx, op, msg); err != nil {
+		return err
+	}
+	if _, err := op.wait(ctx, c); err != nil {
+		return err
+	}
+	var missed []json.RawMessage
+	if sub.resumer != nil {
+		if missed, err = sub.resumer.Resume(ctx, c); err != nil {
+			return err
+		}
+	}
+	select {
+	case sub.resume <- missed:
+	case <-sub.forwardDone:
+	}
+	return nil
+}
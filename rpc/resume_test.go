@@ -0,0 +1,206 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// counterService notifies subscribers of every increment of a shared counter.
+type counterService struct {
+	value atomic.Int64
+}
+
+func (s *counterService) Current() int64 {
+	return s.value.Load()
+}
+
+func (s *counterService) Ticks(ctx context.Context) (*Subscription, error) {
+	notifier, supported := NotifierFromContext(ctx)
+	if !supported {
+		return nil, ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		ticker := time.NewTicker(5 * time.Millisecond)
+		defer ticker.Stop()
+
+		last := s.value.Load()
+		for {
+			select {
+			case <-ticker.C:
+				for cur := s.value.Load(); last < cur; {
+					last++
+					notifier.Notify(sub.ID, last)
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// counterResumer retrieves the increments missed while disconnected.
+type counterResumer struct {
+	last int64
+}
+
+func (r *counterResumer) Init(ctx context.Context, c *Client) error {
+	return c.CallContext(ctx, &r.last, "counter_current")
+}
+
+func (r *counterResumer) Filter(result json.RawMessage) bool {
+	var value int64
+	if err := json.Unmarshal(result, &value); err != nil || value <= r.last {
+		return false
+	}
+	r.last = value
+	return true
+}
+
+func (r *counterResumer) Resume(ctx context.Context, c *Client) ([]json.RawMessage, error) {
+	var current int64
+	if err := c.CallContext(ctx, &current, "counter_current"); err != nil {
+		return nil, err
+	}
+	var missed []json.RawMessage
+	for value := r.last + 1; value <= current; value++ {
+		enc, _ := json.Marshal(value)
+		missed = append(missed, enc)
+	}
+	return missed, nil
+}
+
+// Tests that an auto reconnecting client re-establishes its subscriptions after
+// the connection is lost, delivering a gap-free stream of notifications.
+func TestClientAutoReconnectSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		counter = new(counterService)
+		backend atomic.Pointer[Server]
+	)
+	newServer := func() *Server {
+		srv := NewServer()
+		if err := srv.RegisterName("counter", counter); err != nil {
+			t.Fatal(err)
+		}
+		return srv
+	}
+	httpsrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv := backend.Load()
+		if srv == nil {
+			http.Error(w, "server down", http.StatusServiceUnavailable)
+			return
+		}
+		srv.WebsocketHandler([]string{"*"}).ServeHTTP(w, r)
+	}))
+	defer httpsrv.Close()
+
+	s1 := newServer()
+	backend.Store(s1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := DialOptions(ctx, "ws://"+strings.TrimPrefix(httpsrv.URL, "http://"), WithAutoReconnect())
+	if err != nil {
+		t.Fatal("can't dial", err)
+	}
+	defer client.Close()
+
+	ch := make(chan int64, 64)
+	sub, err := client.SubscribeResumable(ctx, "counter", new(counterResumer), ch, "ticks")
+	if err != nil {
+		t.Fatal("can't subscribe", err)
+	}
+	defer sub.Unsubscribe()
+
+	expect := func(from, to int64) {
+		t.Helper()
+		for want := from; want <= to; want++ {
+			select {
+			case have := <-ch:
+				if have != want {
+					t.Fatalf("notification mismatch: have %d, want %d", have, want)
+				}
+			case err := <-sub.Err():
+				t.Fatalf("subscription failed: %v", err)
+			case <-ctx.Done():
+				t.Fatalf("notification %d not received", want)
+			}
+		}
+	}
+	counter.value.Store(5)
+	expect(1, 5)
+
+	// Take the server down, advance the counter and bring a new server up.
+	backend.Store(nil)
+	s1.Stop()
+	counter.value.Store(10)
+	time.Sleep(50 * time.Millisecond)
+	backend.Store(newServer())
+
+	// The missed increments are delivered first, then the new ones.
+	expect(6, 10)
+	counter.value.Store(15)
+	expect(11, 15)
+
+	select {
+	case have := <-ch:
+		t.Fatalf("unexpected notification %d", have)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// Tests that auto reconnection is disabled for clients which can't redial, so
+// their subscriptions fail instead of waiting for a connection forever.
+func TestClientAutoReconnectWithoutRedial(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+
+	cfg := new(clientConfig)
+	WithAutoReconnect().applyOption(cfg)
+
+	p1, p2 := net.Pipe()
+	go server.ServeCodec(NewCodec(p1), 0)
+	client := initClient(NewCodec(p2), new(serviceRegistry), cfg, nil)
+	defer client.Close()
+
+	if client.autoReconnect {
+		t.Fatal("auto reconnection enabled without connect function")
+	}
+	sub, err := client.Subscribe(context.Background(), "nftest", make(chan int), "someSubscription", 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2.Close()
+	select {
+	case <-sub.Err():
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not closed after connection loss")
+	}
+}
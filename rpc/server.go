@@ -56,6 +56,9 @@ type Server struct {
 	batchResponseLimit int
 	httpBodyLimit      int
 	wsReadLimit        int64
+	rateLimiter        *RateLimiter
+	accessControl      *AccessControl
+	recorder           *Recorder
 	tracerProvider     trace.TracerProvider
 }
 
@@ -101,6 +104,33 @@ func (s *Server) SetWebsocketReadLimit(limit int64) {
 	s.wsReadLimit = limit
 }
 
+// SetRateLimiter sets the limiter throttling the requests of clients. Passing nil
+// disables rate limiting.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetRateLimiter(limiter *RateLimiter) {
+	s.rateLimiter = limiter
+}
+
+// SetAccessControl sets the rules restricting the methods clients may call. Passing
+// nil allows all methods of the registered services.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetAccessControl(ac *AccessControl) {
+	s.accessControl = ac
+}
+
+// SetRecorder sets the recorder writing the served method calls to a log. Passing
+// nil disables recording.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetRecorder(recorder *Recorder) {
+	s.recorder = recorder
+}
+
 // RegisterName creates a service for the given receiver type under the given name. When no
 // methods on the given receiver match the criteria to be either an RPC method or a
 // subscription an error is returned. Otherwise a new service is created and added to the
@@ -126,8 +156,11 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 		idgen:              s.idgen,
 		batchItemLimit:     s.batchItemLimit,
 		batchResponseLimit: s.batchResponseLimit,
+		rateLimiter:        s.rateLimiter,
+		accessControl:      s.accessControl,
+		recorder:           s.recorder,
 	}
-	c := initClient(codec, &s.services, cfg)
+	c := initClient(codec, &s.services, cfg, nil)
 	<-codec.closed()
 	c.Close()
 }
@@ -170,6 +203,9 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 
 	h := newHandler(ctx, codec, s.idgen, &s.services, s.batchItemLimit, s.batchResponseLimit, s.tracerProvider)
 	h.allowSubscribe = false
+	h.rateLimiter = s.rateLimiter
+	h.accessControl = s.accessControl
+	h.recorder = s.recorder
 	defer h.close(io.EOF, nil)
 
 	reqs, batch, err := codec.readBatch()
@@ -256,10 +292,33 @@ type PeerInfo struct {
 		Origin    string
 		Host      string
 	}
+
+	// Subject of the authenticated client, e.g. the 'sub' claim of its JWT token.
+	// This is empty if the client was not authenticated or has no subject.
+	Subject string
+
+	// ClientCertSubject is the subject of the verified TLS client certificate. This
+	// is empty if the connection wasn't authenticated by a client certificate.
+	ClientCertSubject string
 }
 
 type peerInfoContextKey struct{}
 
+type subjectContextKey struct{}
+
+// ContextWithSubject returns a copy of ctx carrying the authenticated subject of the
+// client. HTTP middleware authenticating clients in front of the server can use this
+// to make the subject available through PeerInfo.
+func ContextWithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectContextKey{}, subject)
+}
+
+// subjectFromContext returns the subject set by ContextWithSubject.
+func subjectFromContext(ctx context.Context) string {
+	subject, _ := ctx.Value(subjectContextKey{}).(string)
+	return subject
+}
+
 // PeerInfoFromContext returns information about the client's network connection.
 // Use this with the context passed to RPC method handler functions.
 //
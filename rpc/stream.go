@@ -0,0 +1,268 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Stream is implemented by method results which are encoded incrementally while
+// the response is being written, instead of being marshaled into memory in full
+// first. Large results like logs or block traces can return a Stream to avoid
+// holding multiple encoded copies of the response in memory.
+//
+// Streams are written straight into the connection by the HTTP, WebSocket and
+// IPC transports. The first part of the output is buffered, such that streams
+// failing early are still answered with an error response. Other transports and
+// batch requests encode the stream into a buffer.
+//
+// Once the response has been partially sent, an error can no longer be reported
+// in the response. The response is broken off instead: the connection is closed,
+// or the HTTP response body ends with incomplete JSON, so clients can't mistake
+// the truncated result for a successful one.
+type Stream interface {
+	EncodeJSON(w io.Writer) error
+}
+
+// ArrayStream is a Stream encoding a JSON array whose elements are produced by
+// an iterator. Elements are encoded one by one as they are yielded.
+type ArrayStream[T any] struct {
+	seq iter.Seq2[T, error]
+}
+
+// NewArrayStream creates a stream of the elements yielded by seq. Iteration is
+// aborted by the first non-nil error.
+func NewArrayStream[T any](seq iter.Seq2[T, error]) *ArrayStream[T] {
+	return &ArrayStream[T]{seq: seq}
+}
+
+// NewSliceStream creates a stream of the elements of a slice.
+func NewSliceStream[T any](s []T) *ArrayStream[T] {
+	return NewArrayStream(func(yield func(T, error) bool) {
+		for _, v := range s {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	})
+}
+
+// All returns the iterator of the stream elements.
+func (s *ArrayStream[T]) All() iter.Seq2[T, error] {
+	return s.seq
+}
+
+// Collect gathers all stream elements into a slice. The returned slice is never
+// nil if no error occurred.
+func (s *ArrayStream[T]) Collect() ([]T, error) {
+	items := []T{}
+	for v, err := range s.seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return slices.Clip(items), nil
+}
+
+// EncodeJSON implements Stream.
+func (s *ArrayStream[T]) EncodeJSON(w io.Writer) error {
+	if s == nil {
+		_, err := io.WriteString(w, "null")
+		return err
+	}
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	first := true
+	for v, err := range s.seq {
+		if err != nil {
+			return err
+		}
+		enc, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		if _, err := w.Write(enc); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding the entire stream in memory.
+func (s *ArrayStream[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := s.EncodeJSON(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// materialize encodes the streamed result of a response into memory, for
+// transports and batches which can't write streams incrementally.
+func (msg *jsonrpcMessage) materialize() *jsonrpcMessage {
+	if msg == nil || msg.stream == nil {
+		return msg
+	}
+	var buf bytes.Buffer
+	if err := msg.stream.EncodeJSON(&buf); err != nil {
+		return msg.errorResponse(&internalServerError{errcodeMarshalError, err.Error()})
+	}
+	return &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: buf.Bytes()}
+}
+
+// streamSpoolLimit is the amount of streamed output buffered before a response
+// is sent. Results smaller than this are sent in one piece once complete, so they
+// don't hold up other responses and notifications of the connection while being
+// produced, and failures are reported as regular error responses.
+const streamSpoolLimit = 1024 * 1024
+
+// nopWriteCloser turns a writer into an io.WriteCloser.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// deadlineWriter refreshes the write deadline of a connection before every write,
+// such that slow to produce streams don't run into the deadline of the response.
+type deadlineWriter struct {
+	io.Writer
+	conn deadlineCloser
+}
+
+func (w *deadlineWriter) Write(p []byte) (int, error) {
+	w.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout))
+	return w.Writer.Write(p)
+}
+
+// streamWriter receives the output of a stream. The output is spooled in memory
+// until it exceeds streamSpoolLimit, at which point the response is committed:
+// the encoder lock of the codec is taken and the output is written through to
+// the connection from then on.
+type streamWriter struct {
+	ctx   context.Context
+	codec *jsonCodec
+	id    json.RawMessage
+
+	spool     bytes.Buffer
+	committed bool
+	out       io.WriteCloser // message writer of the connection, once committed
+	buf       *bufio.Writer  // buffered writer of out
+	err       error          // first error writing to the connection
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if !w.committed {
+		// Stop producing the stream if the request was aborted before the
+		// response is sent.
+		if err := w.ctx.Err(); err != nil {
+			return 0, err
+		}
+		if w.spool.Len()+len(p) <= streamSpoolLimit {
+			return w.spool.Write(p)
+		}
+		if err := w.commit(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.buf.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// commit takes the encoder lock and writes the response header and the spooled
+// output to the connection.
+func (w *streamWriter) commit() error {
+	w.codec.encMu.Lock()
+	w.committed = true
+
+	w.out, w.err = w.codec.streamWriter()
+	if w.err != nil {
+		return w.err
+	}
+	w.buf = bufio.NewWriter(&deadlineWriter{Writer: w.out, conn: w.codec.conn})
+	w.buf.WriteString(`{"jsonrpc":"2.0","id":`)
+	w.buf.Write(w.id)
+	w.buf.WriteString(`,"result":`)
+	if _, w.err = w.buf.Write(w.spool.Bytes()); w.err != nil {
+		return w.err
+	}
+	w.spool = bytes.Buffer{}
+	return nil
+}
+
+// writeStream writes a response with a streamed result to the connection. The
+// encoder lock is only held once the response is committed.
+func (c *jsonCodec) writeStream(ctx context.Context, msg *jsonrpcMessage) error {
+	w := &streamWriter{ctx: ctx, codec: c, id: msg.ID}
+	err := msg.stream.EncodeJSON(w)
+	if !w.committed {
+		// The result is complete or failed before any output was sent, write
+		// it as a regular response. Streams aborted by the request timeout are
+		// answered with the timeout error.
+		if err != nil {
+			var rerr *internalServerError
+			if !errors.As(context.Cause(ctx), &rerr) {
+				rerr = &internalServerError{errcodeMarshalError, err.Error()}
+			}
+			return c.writeJSON(ctx, msg.errorResponse(rerr), true)
+		}
+		return c.writeJSON(ctx, &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: w.spool.Bytes()}, false)
+	}
+	defer c.encMu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	if err != nil {
+		// The stream failed after part of the response was sent, there is no way
+		// to report the error. The response is left incomplete and the connection
+		// is closed, such that the client fails to decode it.
+		log.Warn("Failed to stream RPC response", "id", string(msg.ID), "err", err)
+		c.close()
+		return err
+	}
+	w.buf.WriteString("}\n")
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	return w.out.Close()
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestArrayStreamCollect(t *testing.T) {
+	t.Parallel()
+
+	items, err := NewSliceStream([]int{1, 2, 3}).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || items[0] != 1 || items[2] != 3 {
+		t.Fatalf("wrong items: %v", items)
+	}
+	enc, err := NewSliceStream([]string(nil)).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != "[]" {
+		t.Fatalf("wrong encoding of empty stream: %s", enc)
+	}
+}
+
+func TestStreamTransports(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer()
+	defer server.Stop()
+
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+	wssrv := httptest.NewServer(server.WebsocketHandler(nil))
+	defer wssrv.Close()
+
+	urls := map[string]string{
+		"http": httpsrv.URL,
+		"ws":   "ws:" + strings.TrimPrefix(wssrv.URL, "http:"),
+	}
+	for name, url := range urls {
+		t.Run(name, func(t *testing.T) {
+			client, err := DialContext(context.Background(), url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			// Stream a result larger than the write buffers.
+			const n = 100000
+			var result []int
+			if err := client.Call(&result, "test_stream", n); err != nil {
+				t.Fatal(err)
+			}
+			if len(result) != n {
+				t.Fatalf("wrong result length: %d", len(result))
+			}
+			for i, v := range result {
+				if v != i {
+					t.Fatalf("wrong element %d: %d", i, v)
+				}
+			}
+			// Streams failing before the response is sent yield an error response.
+			err = client.Call(&result, "test_stream", n, n/2)
+			if jerr, ok := err.(*jsonError); !ok || jerr.Message != "stream failure" {
+				t.Fatalf("wrong error for failing stream: %v", err)
+			}
+			// Streams failing midway break the response, such that the truncated
+			// result can't be mistaken for a complete one.
+			const large = 1000000
+			var raw []json.RawMessage
+			if err := client.Call(&raw, "test_stream", large, large-1); err == nil {
+				t.Fatalf("no error for stream failing midway, got %d elements", len(raw))
+			}
+			// The server remains usable.
+			client2, err := DialContext(context.Background(), url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client2.Close()
+			if err := client2.Call(&result, "test_stream", 3); err != nil || len(result) != 3 {
+				t.Fatalf("call after failed stream: %v", err)
+			}
+		})
+	}
+}
@@ -207,11 +207,20 @@ type ClientSubscription struct {
 	etype     reflect.Type
 	channel   reflect.Value
 	namespace string
+	args      []interface{} // Subscription arguments, kept to resubscribe after a reconnect
 	subid     string
+	subidLock sync.Mutex // Protects subid, which changes when resubscribing
 
 	// The in channel receives notification values from client dispatcher.
 	in chan json.RawMessage
 
+	// While the subscription is re-established after a reconnect, notifications
+	// are held back until the missed ones are received on the resume channel.
+	resumer    SubscriptionResumer
+	resumeLock sync.Mutex // Serializes resumptions
+	hold       chan struct{}
+	resume     chan []json.RawMessage
+
 	// The error channel receives the error from the forwarding loop.
 	// It is closed by Unsubscribe.
 	err     chan error
@@ -228,13 +237,16 @@ type ClientSubscription struct {
 // This is the sentinel value sent on sub.quit when Unsubscribe is called.
 var errUnsubscribed = errors.New("unsubscribed")
 
-func newClientSubscription(c *Client, namespace string, channel reflect.Value) *ClientSubscription {
+func newClientSubscription(c *Client, namespace string, channel reflect.Value, args []interface{}) *ClientSubscription {
 	sub := &ClientSubscription{
 		client:      c,
 		namespace:   namespace,
+		args:        args,
 		etype:       channel.Type().Elem(),
 		channel:     channel,
 		in:          make(chan json.RawMessage),
+		hold:        make(chan struct{}),
+		resume:      make(chan []json.RawMessage),
 		quit:        make(chan error),
 		forwardDone: make(chan struct{}),
 		unsubDone:   make(chan struct{}),
@@ -278,6 +290,20 @@ func (sub *ClientSubscription) deliver(result json.RawMessage) (ok bool) {
 	}
 }
 
+// id returns the current server-side ID of the subscription.
+func (sub *ClientSubscription) id() string {
+	sub.subidLock.Lock()
+	defer sub.subidLock.Unlock()
+	return sub.subid
+}
+
+// setID updates the server-side ID of the subscription.
+func (sub *ClientSubscription) setID(id string) {
+	sub.subidLock.Lock()
+	defer sub.subidLock.Unlock()
+	sub.subid = id
+}
+
 // close is called by the client's message dispatcher when the connection is closed.
 func (sub *ClientSubscription) close(err error) {
 	select {
@@ -319,19 +345,39 @@ func (sub *ClientSubscription) forward() (unsubscribeServer bool, err error) {
 	cases := []reflect.SelectCase{
 		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.quit)},
 		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.in)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.hold)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.resume)},
 		{Dir: reflect.SelectSend, Chan: sub.channel},
 	}
-	buffer := list.New()
-
+	var (
+		buffer  = list.New()
+		held    []json.RawMessage // Notifications held back while resuming
+		holding bool              // Whether a resumption is in progress
+	)
+	// queue filters out duplicate notifications and buffers the rest.
+	queue := func(result json.RawMessage) error {
+		if sub.resumer != nil && !sub.resumer.Filter(result) {
+			return nil
+		}
+		val, err := sub.unmarshal(result)
+		if err != nil {
+			return err
+		}
+		if buffer.Len() == maxClientSubscriptionBuffer {
+			return ErrSubscriptionQueueOverflow
+		}
+		buffer.PushBack(val)
+		return nil
+	}
 	for {
 		var chosen int
 		var recv reflect.Value
 		if buffer.Len() == 0 {
 			// Idle, omit send case.
-			chosen, recv, _ = reflect.Select(cases[:2])
+			chosen, recv, _ = reflect.Select(cases[:4])
 		} else {
 			// Non-empty buffer, send the first queued item.
-			cases[2].Send = reflect.ValueOf(buffer.Front().Value)
+			cases[4].Send = reflect.ValueOf(buffer.Front().Value)
 			chosen, recv, _ = reflect.Select(cases)
 		}
 
@@ -347,17 +393,38 @@ func (sub *ClientSubscription) forward() (unsubscribeServer bool, err error) {
 			return false, err
 
 		case 1: // <-sub.in
-			val, err := sub.unmarshal(recv.Interface().(json.RawMessage))
-			if err != nil {
+			result := recv.Interface().(json.RawMessage)
+			if holding {
+				if len(held) == maxClientSubscriptionBuffer {
+					return true, ErrSubscriptionQueueOverflow
+				}
+				held = append(held, result)
+				continue
+			}
+			if err := queue(result); err != nil {
 				return true, err
 			}
-			if buffer.Len() == maxClientSubscriptionBuffer {
-				return true, ErrSubscriptionQueueOverflow
+
+		case 2: // <-sub.hold
+			holding = true
+
+		case 3: // <-sub.resume
+			// Deliver the missed notifications ahead of the ones received on the
+			// new subscription meanwhile.
+			for _, result := range recv.Interface().([]json.RawMessage) {
+				if err := queue(result); err != nil {
+					return true, err
+				}
+			}
+			for _, result := range held {
+				if err := queue(result); err != nil {
+					return true, err
+				}
 			}
-			buffer.PushBack(val)
+			held, holding = nil, false
 
-		case 2: // sub.channel<-
-			cases[2].Send = reflect.Value{} // Don't hold onto the value.
+		case 4: // sub.channel<-
+			cases[4].Send = reflect.Value{} // Don't hold onto the value.
 			buffer.Remove(buffer.Front())
 		}
 	}
@@ -373,6 +440,6 @@ func (sub *ClientSubscription) requestUnsubscribe() error {
 	var result interface{}
 	ctx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
 	defer cancel()
-	err := sub.client.CallContext(ctx, &result, sub.namespace+unsubscribeMethodSuffix, sub.subid)
+	err := sub.client.CallContext(ctx, &result, sub.namespace+unsubscribeMethodSuffix, sub.id())
 	return err
 }
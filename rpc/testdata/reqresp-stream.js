@@ -0,0 +1,12 @@
+// This test checks calls of methods returning a streamed result.
+
+--> {"jsonrpc":"2.0","id":1,"method":"test_stream","params":[3]}
+<-- {"jsonrpc":"2.0","id":1,"result":[0,1,2]}
+
+--> {"jsonrpc":"2.0","id":2,"method":"test_stream","params":[0]}
+<-- {"jsonrpc":"2.0","id":2,"result":[]}
+
+// Streams are encoded up front in batches, failures become error responses.
+
+--> [{"jsonrpc":"2.0","id":3,"method":"test_stream","params":[2]}, {"jsonrpc":"2.0","id":4,"method":"test_stream","params":[2,1]}]
+<-- [{"jsonrpc":"2.0","id":3,"result":[0,1]},{"jsonrpc":"2.0","id":4,"error":{"code":-32603,"message":"stream failure"}}]
@@ -132,6 +132,22 @@ func (s *testService) MarshalError() *MarshalErrObj {
 	return &MarshalErrObj{}
 }
 
+// Stream returns a stream of the numbers up to n. If failAt is given, the stream
+// fails after yielding that many elements.
+func (s *testService) Stream(n int, failAt *int) *ArrayStream[int] {
+	return NewArrayStream(func(yield func(int, error) bool) {
+		for i := 0; i < n; i++ {
+			if failAt != nil && i == *failAt {
+				yield(0, errors.New("stream failure"))
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+	})
+}
+
 func (s *testService) Panic() string {
 	panic("service panic")
 }
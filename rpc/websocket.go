@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -61,6 +62,8 @@ func (s *Server) WebsocketHandler(allowedOrigins []string) http.Handler {
 			return
 		}
 		codec := newWebsocketCodec(conn, r.Host, r.Header, s.wsReadLimit)
+		codec.info.Subject = subjectFromContext(r.Context())
+		codec.info.ClientCertSubject = clientCertSubject(r)
 		s.ServeCodec(codec, 0)
 	})
 }
@@ -291,7 +294,7 @@ type websocketCodec struct {
 	pongReceived chan struct{}
 }
 
-func newWebsocketCodec(conn *websocket.Conn, host string, req http.Header, readLimit int64) ServerCodec {
+func newWebsocketCodec(conn *websocket.Conn, host string, req http.Header, readLimit int64) *websocketCodec {
 	conn.SetReadLimit(readLimit)
 	encode := func(v interface{}, isErrorResponse bool) error {
 		return conn.WriteJSON(v)
@@ -306,6 +309,9 @@ func newWebsocketCodec(conn *websocket.Conn, host string, req http.Header, readL
 			RemoteAddr: conn.RemoteAddr().String(),
 		},
 	}
+	wc.jsonCodec.streamWriter = func() (io.WriteCloser, error) {
+		return conn.NextWriter(websocket.TextMessage)
+	}
 	// Fill in connection details.
 	wc.info.HTTP.Host = host
 	wc.info.HTTP.Origin = req.Get("Origin")